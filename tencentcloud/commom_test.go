@@ -1,7 +1,13 @@
 package tencentcloud
 
 import (
+	"fmt"
+	"os"
 	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	sdkErrors "github.com/liucy1983/tencentcloud-sdk-go/tencentcloud/common/errors"
 )
 
 func TestIsContains(t *testing.T) {
@@ -71,3 +77,87 @@ func TestIsContains(t *testing.T) {
 		}
 	}
 }
+
+func TestResolveRetryTimeout(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Duration
+	}{
+		{"", 3 * time.Minute},
+		{"abc", 3 * time.Minute},
+		{"-1m", 3 * time.Minute},
+		{"0s", 3 * time.Minute},
+		{"30s", 30 * time.Second},
+		{"10m", 10 * time.Minute},
+	}
+	for _, test := range tests {
+		os.Setenv("TENCENTCLOUD_TEST_RETRY_TIMEOUT", test.value)
+		if got := resolveRetryTimeout("TENCENTCLOUD_TEST_RETRY_TIMEOUT", 3*time.Minute); got != test.expected {
+			t.Errorf("resolveRetryTimeout(%q) = %v, expected %v", test.value, got, test.expected)
+		}
+	}
+	os.Unsetenv("TENCENTCLOUD_TEST_RETRY_TIMEOUT")
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	notFound := sdkErrors.NewTencentCloudSDKError("ResourceNotFound.InstanceNotFound", "instance not found", "req-1")
+	tests := []struct {
+		err      error
+		expected bool
+	}{
+		{nil, false},
+		{fmt.Errorf("plain error"), false},
+		{sdkErrors.NewTencentCloudSDKError("InternalError", "internal error", "req-2"), false},
+		{notFound, true},
+		{errors.Wrap(notFound, "describe instance"), true},
+		{sdkErrors.NewTencentCloudSDKError("InvalidParameterValue.InstanceNotExist", "instance not exist", "req-3"), true},
+	}
+	for _, test := range tests {
+		if got := isNotFoundError(test.err); got != test.expected {
+			t.Errorf("isNotFoundError(%v) = %v, expected %v", test.err, got, test.expected)
+		}
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	// three full pages followed by a short one
+	pageSizes := []int{10, 10, 10, 3}
+	var offsets []int64
+	err := paginate(nil, 10, 100, "TestAction", func(offset int64) (int, error) {
+		offsets = append(offsets, offset)
+		return pageSizes[len(offsets)-1], nil
+	})
+	if err != nil {
+		t.Errorf("paginate returned unexpected error: %v", err)
+	}
+	expected := []int64{0, 10, 20, 30}
+	if len(offsets) != len(expected) {
+		t.Fatalf("paginate fetched %d pages, expected %d", len(offsets), len(expected))
+	}
+	for i, offset := range offsets {
+		if offset != expected[i] {
+			t.Errorf("page %d fetched at offset %d, expected %d", i, offset, expected[i])
+		}
+	}
+
+	// a fetch error stops the loop and is returned as is
+	fetchErr := fmt.Errorf("fetch failed")
+	if err := paginate(nil, 10, 100, "TestAction", func(offset int64) (int, error) {
+		return 0, fetchErr
+	}); err != fetchErr {
+		t.Errorf("paginate returned %v, expected %v", err, fetchErr)
+	}
+
+	// a server stuck returning full pages hits the page cap
+	pages := 0
+	err = paginate(nil, 10, 5, "TestAction", func(offset int64) (int, error) {
+		pages++
+		return 10, nil
+	})
+	if err == nil {
+		t.Errorf("paginate did not report the page cap")
+	}
+	if pages != 5 {
+		t.Errorf("paginate fetched %d pages before the cap, expected 5", pages)
+	}
+}
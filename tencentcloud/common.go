@@ -9,7 +9,9 @@ import (
 	"os"
 	"os/user"
 	"reflect"
+	"regexp"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -30,11 +32,73 @@ const logIdKey = contextLogId("logId")
 var logFirstTime = ""
 var logAtomicId int64 = 0
 
-// readRetryTimeout is read retry timeout
-const readRetryTimeout = 3 * time.Minute
+// PROVIDER_READ_RETRY_TIMEOUT and PROVIDER_WRITE_RETRY_TIMEOUT override the
+// retry timeouts with a duration string such as "30s" or "10m".
+const (
+	PROVIDER_READ_RETRY_TIMEOUT  = "TENCENTCLOUD_READ_RETRY_TIMEOUT"
+	PROVIDER_WRITE_RETRY_TIMEOUT = "TENCENTCLOUD_WRITE_RETRY_TIMEOUT"
+)
+
+// readRetryTimeout is read retry timeout, resolved once at startup
+var readRetryTimeout = resolveRetryTimeout(PROVIDER_READ_RETRY_TIMEOUT, 3*time.Minute)
+
+// writeRetryTimeout is write retry timeout, resolved once at startup
+var writeRetryTimeout = resolveRetryTimeout(PROVIDER_WRITE_RETRY_TIMEOUT, 5*time.Minute)
+
+// retryWithContext behaves like resource.Retry but gives up as soon as ctx is
+// cancelled, so aborting a Terraform operation stops the polling loops instead
+// of letting them run until the timeout. The plugin SDK in use has no
+// RetryContext, hence the local wrapper.
+func retryWithContext(ctx context.Context, timeout time.Duration, f resource.RetryFunc) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		if ctx != nil {
+			if err := ctx.Err(); err != nil {
+				return resource.NonRetryableError(err)
+			}
+		}
+		return f()
+	})
+}
+
+// paginate drives an offset/limit describe loop: fetchPage issues one request
+// at the given offset and reports how many items the page held, and paging
+// stops at the first short page. ctx cancellation is honored between pages and
+// maxPages caps the loop, so a server stuck returning full pages cannot spin
+// forever.
+func paginate(ctx context.Context, limit int64, maxPages int, action string, fetchPage func(offset int64) (count int, err error)) error {
+	for pages, offset := 0, int64(0); ; pages, offset = pages+1, offset+limit {
+		if ctx != nil {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		if pages >= maxPages {
+			return fmt.Errorf("api[%s] still returns full pages after %d pages, aborting pagination", action, maxPages)
+		}
+		count, err := fetchPage(offset)
+		if err != nil {
+			return err
+		}
+		if int64(count) < limit {
+			return nil
+		}
+	}
+}
 
-// writeRetryTimeout is write retry timeout
-const writeRetryTimeout = 5 * time.Minute
+// resolveRetryTimeout reads a duration override from the environment, keeping
+// the default on an empty, malformed or non-positive value.
+func resolveRetryTimeout(envKey string, defaultTimeout time.Duration) time.Duration {
+	value := os.Getenv(envKey)
+	if value == "" {
+		return defaultTimeout
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		log.Printf("[WARN] invalid %s value %s, keeping default %v", envKey, value, defaultTimeout)
+		return defaultTimeout
+	}
+	return timeout
+}
 
 // InternalError common internalError, do not add in retryableErrorCode,
 // because when some product return this error, retry won't fix anything.
@@ -56,6 +120,15 @@ var retryableErrorCode = []string{
 	"ResourceBusy",
 }
 
+// sensitiveBodyFields matches JSON fields whose values must never reach logs.
+var sensitiveBodyFields = regexp.MustCompile(`"(Password|PasswordNew)"\s*:\s*"(?:\\.|[^"\\])*"`)
+
+// redactRequestBody hides the values of sensitive fields such as passwords,
+// so request bodies can be logged on failure without leaking credentials.
+func redactRequestBody(body string) string {
+	return sensitiveBodyFields.ReplaceAllString(body, `"$1":"***"`)
+}
+
 func init() {
 	logFirstTime = fmt.Sprintf("%d", time.Now().UnixNano()/int64(time.Millisecond))
 }
@@ -114,6 +187,50 @@ func retryError(err error, additionRetryableError ...string) *resource.RetryErro
 	return resource.NonRetryableError(err)
 }
 
+// retryCounters aggregates how many retries each API action accumulated during
+// this run, keyed by request.GetAction().
+var retryCounters sync.Map
+
+// recordRetry adds one retry for the action and logs the running total, giving
+// users data to tune the rate_limit budgets without attaching a profiler.
+func recordRetry(action string) {
+	value, _ := retryCounters.LoadOrStore(action, new(int64))
+	total := atomic.AddInt64(value.(*int64), 1)
+	log.Printf("[DEBUG] api[%s] accumulated %d retries", action, total)
+}
+
+// retryErrorWithAction behaves like retryError and additionally accounts every
+// retryable error against the action, so on large applies the throttled APIs
+// show up in the debug log.
+func retryErrorWithAction(action string, err error, additionRetryableError ...string) *resource.RetryError {
+	retryErr := retryError(err, additionRetryableError...)
+	if retryErr != nil && retryErr.Retryable {
+		recordRetry(action)
+	}
+	return retryErr
+}
+
+// isSdkErrorCode returns whether err is a TencentCloudSDKError carrying one of
+// the given codes. A category code such as `ResourceNotFound` also matches its
+// dotted sub-codes, following the isExpectError convention.
+func isSdkErrorCode(err error, codes ...string) bool {
+	if err == nil {
+		return false
+	}
+	e, ok := errors.Cause(err).(*sdkErrors.TencentCloudSDKError)
+	if !ok {
+		return false
+	}
+	return isExpectError(e, codes)
+}
+
+// isNotFoundError returns whether err reports that the requested resource
+// does not exist, so describe paths can map it to "not found" instead of
+// failing.
+func isNotFoundError(err error) bool {
+	return isSdkErrorCode(err, "ResourceNotFound", "InvalidParameterValue.InstanceNotExist")
+}
+
 // isExpectError returns whether error is expect error
 func isExpectError(err error, expectError []string) bool {
 	e, ok := err.(*sdkErrors.TencentCloudSDKError)
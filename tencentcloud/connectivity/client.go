@@ -2,6 +2,7 @@ package connectivity
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -70,6 +71,11 @@ type TencentCloudClient struct {
 	sqlserverConn *sqlserver.Client
 	postgreConn   *postgre.Client
 	ckafkaConn    *ckafka.Client
+
+	// per-region sqlserver clients for resources overriding the provider region;
+	// guarded by sqlserverRegionConnLock, resources are applied concurrently
+	// and an unguarded map write crashes the process
+	sqlserverRegionConn map[string]*sqlserver.Client
 }
 
 // NewClientProfile returns a new ClientProfile
@@ -431,6 +437,38 @@ func (me *TencentCloudClient) UseSqlserverClient() *sqlserver.Client {
 	return me.sqlserverConn
 }
 
+// sqlserverRegionConnLock serializes access to sqlserverRegionConn. It lives
+// outside TencentCloudClient because the client is copied by value in places,
+// which a struct-embedded mutex does not survive.
+var sqlserverRegionConnLock sync.Mutex
+
+// UseSqlserverClientRegion returns a sqlserver client bound to the given region,
+// falling back to the provider region client when region is empty or identical.
+// Per-region clients are cached just like the default one.
+func (me *TencentCloudClient) UseSqlserverClientRegion(region string) *sqlserver.Client {
+	if region == "" || region == me.Region {
+		return me.UseSqlserverClient()
+	}
+
+	sqlserverRegionConnLock.Lock()
+	defer sqlserverRegionConnLock.Unlock()
+
+	if conn, ok := me.sqlserverRegionConn[region]; ok {
+		return conn
+	}
+
+	cpf := me.NewClientProfile(300)
+	conn, _ := sqlserver.NewClient(me.Credential, region, cpf)
+	conn.WithHttpTransport(&LogRoundTripper{})
+
+	if me.sqlserverRegionConn == nil {
+		me.sqlserverRegionConn = make(map[string]*sqlserver.Client)
+	}
+	me.sqlserverRegionConn[region] = conn
+
+	return conn
+}
+
 // UseCkafkaClient returns ckafka client for service
 func (me *TencentCloudClient) UseCkafkaClient() *ckafka.Client {
 	if me.ckafkaConn != nil {
@@ -0,0 +1,29 @@
+package connectivity
+
+import (
+	"testing"
+
+	"github.com/liucy1983/tencentcloud-sdk-go/tencentcloud/common"
+)
+
+// TestClientReuse confirms the lazily built service clients are constructed
+// once per TencentCloudClient and reused across API calls, so high-volume
+// applies do not pay repeated TLS handshakes.
+func TestClientReuse(t *testing.T) {
+	client := &TencentCloudClient{
+		Credential: common.NewCredential("secret-id", "secret-key"),
+		Region:     "ap-guangzhou",
+	}
+
+	if got := client.UseSqlserverClient(); got == nil {
+		t.Fatal("UseSqlserverClient returned nil")
+	} else if client.UseSqlserverClient() != got {
+		t.Error("UseSqlserverClient constructed a new client on the second call")
+	}
+
+	if got := client.UseCkafkaClient(); got == nil {
+		t.Fatal("UseCkafkaClient returned nil")
+	} else if client.UseCkafkaClient() != got {
+		t.Error("UseCkafkaClient constructed a new client on the second call")
+	}
+}
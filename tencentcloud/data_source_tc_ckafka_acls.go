@@ -47,11 +47,26 @@ func dataSourceTencentCloudCkafkaAcls() *schema.Resource {
 				Optional:    true,
 				Description: "Host substr used for querying.",
 			},
+			"operation_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ACL operation mode used for querying, such as `READ` or `WRITE`. All operations are returned when not set.",
+			},
+			"permission_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ACL permission type used for querying, such as `ALLOW` or `DENY`. All permission types are returned when not set.",
+			},
 			"result_output_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Used to save results.",
 			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of acls reported by the server, used to detect truncated results.",
+			},
 			"acl_list": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -108,11 +123,17 @@ func dataSourceTencentCloudCkafkaAclsRead(d *schema.ResourceData, meta interface
 	if v, ok := d.GetOk("host"); ok {
 		params["host"] = v.(string)
 	}
+	if v, ok := d.GetOk("operation_type"); ok {
+		params["operation_type"] = v.(string)
+	}
+	if v, ok := d.GetOk("permission_type"); ok {
+		params["permission_type"] = v.(string)
+	}
 
 	ckafkaService := CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
-	aclInfos, err := ckafkaService.DescribeAclByFilter(ctx, params)
+	aclInfos, totalCount, err := ckafkaService.DescribeAclByFilter(ctx, params)
 	if err != nil {
 		return err
 	}
@@ -136,6 +157,7 @@ func dataSourceTencentCloudCkafkaAclsRead(d *schema.ResourceData, meta interface
 
 	d.SetId(helper.DataResourceIdsHash(ids))
 	d.Set("acl_list", aclList)
+	d.Set("total_count", totalCount)
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
@@ -0,0 +1,106 @@
+/*
+Use this data source to query detailed consumer group information of Ckafka
+
+Example Usage
+
+```hcl
+data "tencentcloud_ckafka_consumer_groups" "foo" {
+  instance_id = "ckafka-f9ife4zz"
+  search_word = "test"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudCkafkaConsumerGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCkafkaConsumerGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Id of the ckafka instance.",
+			},
+			"search_word": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Keyword used when query ckafka consumer groups. Could be a substr of group name.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"group_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of ckafka consumer groups. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the consumer group.",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Protocol used by the consumer group.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCkafkaConsumerGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_ckafka_consumer_groups.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	instanceId := d.Get("instance_id").(string)
+	searchWord := ""
+	if v, ok := d.GetOk("search_word"); ok {
+		searchWord = v.(string)
+	}
+
+	ckafkaService := CkafkaService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	groupInfos, err := ckafkaService.DescribeCkafkaGroups(ctx, instanceId, searchWord)
+	if err != nil {
+		return err
+	}
+	groupList := make([]map[string]interface{}, 0, len(groupInfos))
+	ids := make([]string, 0, len(groupInfos))
+	for _, group := range groupInfos {
+		groupList = append(groupList, map[string]interface{}{
+			"group_name": *group.Group,
+			"protocol":   *group.Protocol,
+		})
+
+		ids = append(ids, instanceId+FILED_SP+*group.Group)
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	d.Set("group_list", groupList)
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), groupList); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
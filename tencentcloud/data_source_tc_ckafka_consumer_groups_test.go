@@ -0,0 +1,29 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccTencentCloudDataSourceCkafkaConsumerGroups(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTencentCloudDataSourceCkafkaConsumerGroups,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tencentcloud_ckafka_consumer_groups.foo", "instance_id"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_ckafka_consumer_groups.foo", "group_list.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTencentCloudDataSourceCkafkaConsumerGroups = `
+data "tencentcloud_ckafka_consumer_groups" "foo" {
+  instance_id = "ckafka-f9ife4zz"
+}
+`
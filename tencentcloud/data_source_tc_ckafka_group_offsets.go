@@ -0,0 +1,162 @@
+/*
+Use this data source to query committed offset and lag information of a Ckafka consumer group
+
+Example Usage
+
+```hcl
+data "tencentcloud_ckafka_group_offsets" "foo" {
+  instance_id = "ckafka-f9ife4zz"
+  group       = "test-group"
+  topics      = ["topic-tf-test"]
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudCkafkaGroupOffsets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCkafkaGroupOffsetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Id of the ckafka instance.",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the consumer group.",
+			},
+			"topics": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Topic names subscribed by the group. All topics of the group are returned when not set.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"topic_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of topics consumed by the group. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the topic.",
+						},
+						"partitions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "A list of partition offsets of the topic.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"partition": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Id of the partition.",
+									},
+									"offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Offset committed by the consumer.",
+									},
+									"log_end_offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Latest offset of the partition.",
+									},
+									"lag": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Number of unconsumed messages.",
+									},
+									"metadata": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Metadata passed by the consumer when committing, usually an empty string.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCkafkaGroupOffsetsRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_ckafka_group_offsets.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	instanceId := d.Get("instance_id").(string)
+	group := d.Get("group").(string)
+	topics := make([]string, 0)
+	if v, ok := d.GetOk("topics"); ok {
+		for _, topic := range v.([]interface{}) {
+			topics = append(topics, topic.(string))
+		}
+	}
+
+	ckafkaService := CkafkaService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	offsetTopics, err := ckafkaService.DescribeCkafkaGroupOffsets(ctx, instanceId, group, topics)
+	if err != nil {
+		return err
+	}
+	topicList := make([]map[string]interface{}, 0, len(offsetTopics))
+	ids := make([]string, 0, len(offsetTopics))
+	for _, offsetTopic := range offsetTopics {
+		partitions := make([]map[string]interface{}, 0, len(offsetTopic.Partitions))
+		for _, partition := range offsetTopic.Partitions {
+			partitionInfo := map[string]interface{}{
+				"partition": partition.Partition,
+				"offset":    partition.Offset,
+			}
+			if partition.LogEndOffset != nil {
+				partitionInfo["log_end_offset"] = partition.LogEndOffset
+			}
+			if partition.Lag != nil {
+				partitionInfo["lag"] = partition.Lag
+			}
+			if partition.Metadata != nil {
+				partitionInfo["metadata"] = partition.Metadata
+			}
+			partitions = append(partitions, partitionInfo)
+		}
+		topicList = append(topicList, map[string]interface{}{
+			"topic":      *offsetTopic.Topic,
+			"partitions": partitions,
+		})
+
+		ids = append(ids, instanceId+FILED_SP+group+FILED_SP+*offsetTopic.Topic)
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	d.Set("topic_list", topicList)
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), topicList); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
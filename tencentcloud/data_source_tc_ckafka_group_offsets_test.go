@@ -0,0 +1,30 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccTencentCloudDataSourceCkafkaGroupOffsets(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTencentCloudDataSourceCkafkaGroupOffsets,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tencentcloud_ckafka_group_offsets.foo", "instance_id"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_ckafka_group_offsets.foo", "topic_list.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTencentCloudDataSourceCkafkaGroupOffsets = `
+data "tencentcloud_ckafka_group_offsets" "foo" {
+  instance_id = "ckafka-f9ife4zz"
+  group       = "test-group"
+}
+`
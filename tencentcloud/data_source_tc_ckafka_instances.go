@@ -0,0 +1,285 @@
+/*
+Use this data source to query detailed instance information of Ckafka
+
+Example Usage
+
+```hcl
+data "tencentcloud_ckafka_instances" "foo" {
+  search_word = "test"
+  status      = [1]
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudCkafkaInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCkafkaInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Id of the ckafka instance to query.",
+			},
+			"search_word": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Keyword used when query ckafka instances. Could be a substr of instance name.",
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Status of the instances to query. `0`: creating, `1`: running, `2`: deleting. All instances are returned when not set.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"instance_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of ckafka instances. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Id of the instance.",
+						},
+						"instance_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the instance.",
+						},
+						"status": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Status of the instance. `0`: creating, `1`: running, `2`: deleting, `5`: isolated, `-1`: creation failed.",
+						},
+						"zone_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Availability zone id of the instance.",
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Id of the vpc. Empty for classic network.",
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Id of the subnet. Empty for classic network.",
+						},
+						"vip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Virtual ip of the instance.",
+						},
+						"vport": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Virtual port of the instance.",
+						},
+						"vip_list": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Access endpoint list of the instance.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"vip": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Virtual ip.",
+									},
+									"vport": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Virtual port.",
+									},
+								},
+							},
+						},
+						"healthy": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Health status of the instance. `1`: healthy, `2`: warning, `3`: abnormal.",
+						},
+						"healthy_message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health status message of the instance, currently shows disk utilization.",
+						},
+						"bandwidth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Bandwidth of the instance in Mbps.",
+						},
+						"disk_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Disk size of the instance in GB.",
+						},
+						"msg_retention_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Default message retention time of the instance in minutes.",
+						},
+						"config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Automatic topic creation configuration of the instance, empty when automatic creation is not enabled.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_create_topics_enable": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether topics are created automatically on first use.",
+									},
+									"default_num_partitions": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Default number of partitions for automatically created topics.",
+									},
+									"default_replication_factor": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Default replication factor for automatically created topics.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCkafkaInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_ckafka_instances.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	instanceId := ""
+	if v, ok := d.GetOk("instance_id"); ok {
+		instanceId = v.(string)
+	}
+	searchWord := ""
+	if v, ok := d.GetOk("search_word"); ok {
+		searchWord = v.(string)
+	}
+	status := make([]int64, 0)
+	if v, ok := d.GetOk("status"); ok {
+		for _, s := range v.([]interface{}) {
+			status = append(status, int64(s.(int)))
+		}
+	}
+
+	ckafkaService := CkafkaService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	instanceInfos, err := ckafkaService.DescribeCkafkaInstances(ctx, instanceId, searchWord, status)
+	if err != nil {
+		return err
+	}
+	instanceList := make([]map[string]interface{}, 0, len(instanceInfos))
+	ids := make([]string, 0, len(instanceInfos))
+	for _, instance := range instanceInfos {
+		instanceInfo := map[string]interface{}{
+			"instance_id":   *instance.InstanceId,
+			"instance_name": *instance.InstanceName,
+			"status":        *instance.Status,
+		}
+
+		attributes, has, err := ckafkaService.DescribeInstanceById(ctx, *instance.InstanceId)
+		if err != nil {
+			return err
+		}
+		if has {
+			if attributes.ZoneId != nil {
+				instanceInfo["zone_id"] = *attributes.ZoneId
+			}
+			if attributes.VpcId != nil {
+				instanceInfo["vpc_id"] = *attributes.VpcId
+			}
+			if attributes.SubnetId != nil {
+				instanceInfo["subnet_id"] = *attributes.SubnetId
+			}
+			if attributes.Vip != nil {
+				instanceInfo["vip"] = *attributes.Vip
+			}
+			if attributes.Vport != nil {
+				instanceInfo["vport"] = *attributes.Vport
+			}
+			vipList := make([]map[string]interface{}, 0, len(attributes.VipList))
+			for _, vip := range attributes.VipList {
+				entry := make(map[string]interface{})
+				if vip.Vip != nil {
+					entry["vip"] = *vip.Vip
+				}
+				if vip.Vport != nil {
+					entry["vport"] = *vip.Vport
+				}
+				vipList = append(vipList, entry)
+			}
+			instanceInfo["vip_list"] = vipList
+			if attributes.Healthy != nil {
+				instanceInfo["healthy"] = *attributes.Healthy
+			}
+			if attributes.HealthyMessage != nil {
+				instanceInfo["healthy_message"] = *attributes.HealthyMessage
+			}
+			if attributes.Bandwidth != nil {
+				instanceInfo["bandwidth"] = *attributes.Bandwidth
+			}
+			if attributes.DiskSize != nil {
+				instanceInfo["disk_size"] = *attributes.DiskSize
+			}
+			if attributes.MsgRetentionTime != nil {
+				instanceInfo["msg_retention_time"] = *attributes.MsgRetentionTime
+			}
+			config := make([]map[string]interface{}, 0, 1)
+			if attributes.Config != nil {
+				entry := make(map[string]interface{})
+				if attributes.Config.AutoCreateTopicsEnable != nil {
+					entry["auto_create_topics_enable"] = *attributes.Config.AutoCreateTopicsEnable
+				}
+				if attributes.Config.DefaultNumPartitions != nil {
+					entry["default_num_partitions"] = *attributes.Config.DefaultNumPartitions
+				}
+				if attributes.Config.DefaultReplicationFactor != nil {
+					entry["default_replication_factor"] = *attributes.Config.DefaultReplicationFactor
+				}
+				config = append(config, entry)
+			}
+			instanceInfo["config"] = config
+		}
+
+		instanceList = append(instanceList, instanceInfo)
+		ids = append(ids, *instance.InstanceId)
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	d.Set("instance_list", instanceList)
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), instanceList); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccTencentCloudDataSourceCkafkaInstances(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTencentCloudDataSourceCkafkaInstances,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.tencentcloud_ckafka_instances.foo", "instance_list.0.instance_id", "ckafka-f9ife4zz"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_ckafka_instances.foo", "instance_list.0.instance_name"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_ckafka_instances.foo", "instance_list.0.status"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTencentCloudDataSourceCkafkaInstances = `
+data "tencentcloud_ckafka_instances" "foo" {
+  instance_id = "ckafka-f9ife4zz"
+}
+`
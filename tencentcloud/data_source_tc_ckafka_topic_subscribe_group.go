@@ -0,0 +1,114 @@
+/*
+Use this data source to query consumer groups subscribed to a Ckafka topic
+
+Example Usage
+
+```hcl
+data "tencentcloud_ckafka_topic_subscribe_group" "foo" {
+  instance_id = "ckafka-f9ife4zz"
+  topic_name  = "topic-tf-test"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudCkafkaTopicSubscribeGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCkafkaTopicSubscribeGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Id of the ckafka instance.",
+			},
+			"topic_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the topic.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+			"group_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of consumer groups subscribed to the topic. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the consumer group.",
+						},
+						"subscribed_partitions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Partitions of the topic subscribed by the group.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCkafkaTopicSubscribeGroupRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_ckafka_topic_subscribe_group.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	instanceId := d.Get("instance_id").(string)
+	topicName := d.Get("topic_name").(string)
+
+	ckafkaService := CkafkaService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	groups, err := ckafkaService.DescribeCkafkaTopicSubscribeGroup(ctx, instanceId, topicName)
+	if err != nil {
+		return err
+	}
+	groupList := make([]map[string]interface{}, 0, len(groups))
+	ids := make([]string, 0, len(groups))
+	for _, group := range groups {
+		partitions := make([]int64, 0)
+		for _, info := range group.SubscribedInfo {
+			if info.TopicName != nil && *info.TopicName == topicName {
+				for _, partition := range info.Partition {
+					if partition != nil {
+						partitions = append(partitions, *partition)
+					}
+				}
+			}
+		}
+		groupList = append(groupList, map[string]interface{}{
+			"group_name":            *group.ConsumerGroupName,
+			"subscribed_partitions": partitions,
+		})
+
+		ids = append(ids, instanceId+FILED_SP+topicName+FILED_SP+*group.ConsumerGroupName)
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	d.Set("group_list", groupList)
+
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := writeToFile(output.(string), groupList); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
@@ -34,6 +34,12 @@ func dataSourceTencentCloudCkafkaUsers() *schema.Resource {
 				Optional:    true,
 				Description: "Account name used when query ckafka users' infos. Could be a substr of user name.",
 			},
+			"exact_match": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether `account_name` should match the user name exactly instead of as a substr. Default is false.",
+			},
 			"result_output_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -75,9 +81,12 @@ func dataSourceTencentCloudCkafkaUsersRead(d *schema.ResourceData, meta interfac
 
 	params := make(map[string]interface{})
 	params["instance_id"] = d.Get("instance_id").(string)
+	accountName := ""
 	if v, ok := d.GetOk("account_name"); ok {
-		params["account_name"] = v.(string)
+		accountName = v.(string)
+		params["account_name"] = accountName
 	}
+	exactMatch := d.Get("exact_match").(bool)
 
 	ckafkaService := CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
@@ -89,6 +98,10 @@ func dataSourceTencentCloudCkafkaUsersRead(d *schema.ResourceData, meta interfac
 	userList := make([]map[string]interface{}, 0, len(userInfos))
 	ids := make([]string, 0, len(userInfos))
 	for _, user := range userInfos {
+		// SearchWord of the DescribeUser api matches substrings, drop fuzzy hits on demand
+		if exactMatch && accountName != "" && *user.Name != accountName {
+			continue
+		}
 		userList = append(userList, map[string]interface{}{
 			"account_name": *user.Name,
 			"create_time":  *user.CreateTime,
@@ -105,9 +105,9 @@ func dataSourceTencentSqlserverAccountDBAttachmentsRead(d *schema.ResourceData,
 	for _, item := range attachments {
 		mapping := map[string]interface{}{
 			"instance_id":  instanceId,
-			"account_name": item["account_name"],
-			"db_name":      item["db_name"],
-			"privilege":    item["privilege"],
+			"account_name": item.AccountName,
+			"db_name":      item.DBName,
+			"privilege":    item.Privilege,
 		}
 
 		list = append(list, mapping)
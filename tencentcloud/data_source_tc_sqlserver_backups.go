@@ -43,6 +43,20 @@ func dataSourceTencentCloudSqlserverBackups() *schema.Resource {
 				Required:    true,
 				Description: "End time of the instance list, like yyyy-MM-dd HH:mm:ss.",
 			},
+			"strategy": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validateAllowedIntValue([]int{-1, 0, 1}),
+				Description:  "Strategy of the backup to be query. 0 for instance backup, 1 for multi-databases backup. Leave unset to query all.",
+			},
+			"trigger_model": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validateAllowedIntValue([]int{-1, 0, 1}),
+				Description:  "The way the backup was triggered. 0 for timed trigger, 1 for manual trigger. Leave unset to query all.",
+			},
 			"result_output_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -132,12 +146,19 @@ func dataSourceTencentSqlserverBackupsRead(d *schema.ResourceData, meta interfac
 	instanceId := d.Get("instance_id").(string)
 	startTime := d.Get("start_time").(string)
 	endTime := d.Get("end_time").(string)
+	strategy := int64(d.Get("strategy").(int))
+	triggerModel := int64(d.Get("trigger_model").(int))
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 
-	backInfoItems, err := sqlserverService.DescribeSqlserverBackups(ctx, instanceId, startTime, endTime)
+	backInfoItems, totalCount, err := sqlserverService.DescribeSqlserverBackups(ctx, instanceId, startTime, endTime, strategy, triggerModel)
 
 	if err != nil {
-		return fmt.Errorf("api[DescribeBackups]fail, return %s", err.Error())
+		// degrade to the pages fetched before the failure instead of returning nothing
+		if len(backInfoItems) == 0 {
+			return fmt.Errorf("api[DescribeBackups]fail, return %s", err.Error())
+		}
+		log.Printf("[WARN]%s api[DescribeBackups] returned %d of ~%d backups, then failed, reason:%s\n",
+			logId, len(backInfoItems), totalCount, err.Error())
 	}
 
 	var list []map[string]interface{}
@@ -148,6 +169,7 @@ func dataSourceTencentSqlserverBackupsRead(d *schema.ResourceData, meta interfac
 			"start_time":    item.StartTime,
 			"end_time":      item.EndTime,
 			"size":          item.Size,
+			"strategy":      item.Strategy,
 			"trigger_model": item.BackupWay,
 			"intranet_url":  item.InternalAddr,
 			"internet_url":  item.ExternalAddr,
@@ -17,6 +17,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	sqlserver "github.com/liucy1983/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
 )
 
 func dataSourceTencentSqlserverDBs() *schema.Resource {
@@ -33,6 +34,12 @@ func dataSourceTencentSqlserverDBs() *schema.Resource {
 				Required:    true,
 				Description: "SQL Server instance ID which DB belongs to.",
 			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"creating", "running", "modifying", "deleting"}),
+				Description:  "Status to filter with. Valid values are `creating`, `running`, `modifying` and `deleting`. When set, only databases in this status are returned.",
+			},
 			// Computed
 			"db_list": {
 				Type:        schema.TypeList,
@@ -94,7 +101,19 @@ func dataSourceTencentSqlserverDBRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[CRITAL]%s SQL Server instance %s dose not exist", logId, instanceId)
 	}
 
-	dbInfos, err := sqlserverService.DescribeDBsOfInstance(ctx, instanceId)
+	var dbInfos []*sqlserver.DBDetail
+	if v, ok := d.GetOk("status"); ok {
+		var statusCode int64
+		for code, name := range SQLSERVER_DB_STATUS {
+			if name == v.(string) {
+				statusCode = code
+				break
+			}
+		}
+		dbInfos, err = sqlserverService.DescribeSqlserverDBsByStatus(ctx, instanceId, statusCode)
+	} else {
+		dbInfos, err = sqlserverService.DescribeDBsOfInstance(ctx, instanceId)
+	}
 	if err != nil {
 		return err
 	}
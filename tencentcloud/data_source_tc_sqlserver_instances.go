@@ -38,6 +38,16 @@ func dataSourceTencentCloudSqlserverInstances() *schema.Resource {
 				Optional:    true,
 				Description: "ID of the SQL Server instance to be query.",
 			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the SQL Server instance to be query. Fuzzy match is supported.",
+			},
+			"status": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Status of the SQL Server instance to be query. 1 for applying, 2 for running, 3 for running with limit, 4 for isolated, 5 for recycling, 6 for recycled, 7 for running with task, 8 for off-line, 9 for expanding, 10 for migrating, 11 for readonly, 12 for rebooting.",
+			},
 			"project_id": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -53,6 +63,11 @@ func dataSourceTencentCloudSqlserverInstances() *schema.Resource {
 				Optional:    true,
 				Description: "Subnet ID of the SQL Server instance to be query.",
 			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Tags of the SQL Server instance to be query, the query result must match all the given tags.",
+			},
 			"result_output_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -144,11 +159,36 @@ func dataSourceTencentCloudSqlserverInstances() *schema.Resource {
 							Computed:    true,
 							Description: "Create time of the SQL Server instance.",
 						},
+						"pay_mode": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Billing mode of the SQL Server instance. 0 for pay-as-you-go, 1 for monthly subscription.",
+						},
+						"renew_flag": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Automatic renewal sign. 0 for normal renewal, 1 for automatic renewal, 2 for no renewal after expiration. Only valid for prepaid instance.",
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expire time of the SQL Server instance.",
+						},
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique uid of the SQL Server instance.",
+						},
 						"status": {
 							Type:        schema.TypeInt,
 							Computed:    true,
 							Description: "Status of the SQL Server instance. 1 for applying, 2 for running, 3 for running with limit, 4 for isolated, 5 for recycling, 6 for recycled, 7 for running with task, 8 for off-line, 9 for expanding, 10 for migrating, 11 for readonly, 12 for rebooting.",
 						},
+						"tags": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Tags of the SQL Server instance.",
+						},
 					},
 				},
 			},
@@ -162,23 +202,34 @@ func dataSourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta in
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+	client := meta.(*TencentCloudClient).apiV3Conn
+	service := SqlserverService{client: client}
+	tagService := TagService{client: client}
+	region := client.Region
 
 	id := d.Get("id").(string)
+	name := d.Get("name").(string)
 
 	project_id := -1
 	if v, ok := d.GetOk("project_id"); ok {
 		project_id = v.(int)
 	}
 
+	status := -1
+	if v, ok := d.GetOk("status"); ok {
+		status = v.(int)
+	}
+
 	vpc_id := d.Get("vpc_id").(string)
 
 	subnet_id := d.Get("subnet_id").(string)
 
-	instanceList, err := service.DescribeSqlserverInstances(ctx, id, project_id, vpc_id, subnet_id, 0)
+	tags := helper.GetTags(d, "tags")
+
+	instanceList, err := service.DescribeSqlserverInstances(ctx, id, name, project_id, vpc_id, subnet_id, 0, status)
 
 	if err != nil {
-		instanceList, err = service.DescribeSqlserverInstances(ctx, id, project_id, vpc_id, subnet_id, 0)
+		instanceList, err = service.DescribeSqlserverInstances(ctx, id, name, project_id, vpc_id, subnet_id, 0, status)
 	}
 	if err != nil {
 		return err
@@ -187,7 +238,21 @@ func dataSourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta in
 	ids := make([]string, 0, len(instanceList))
 	list := make([]map[string]interface{}, 0, len(instanceList))
 
+INSTANCE_LOOP:
 	for _, v := range instanceList {
+		// DescribeDBInstances does not support tag filters, so match the
+		// requested tags against the tag service result per instance
+		respTags, err := tagService.DescribeResourceTags(ctx, "sqlserver", "instance", region, *v.InstanceId)
+		if err != nil {
+			log.Printf("[CRITAL]%s describe SQL Server instance tags failed: %+v", logId, err)
+			return err
+		}
+		for k, value := range tags {
+			if respTags[k] != value {
+				continue INSTANCE_LOOP
+			}
+		}
+
 		listItem := make(map[string]interface{})
 		listItem["id"] = v.InstanceId
 		listItem["name"] = v.Name
@@ -199,11 +264,19 @@ func dataSourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta in
 		listItem["vpc_id"] = v.UniqVpcId
 		listItem["subnet_id"] = v.UniqSubnetId
 		listItem["engine_version"] = v.Version
+		if v.HAFlag != nil {
+			listItem["ha_type"] = SQLSERVER_HA_TYPE_FLAGS[*v.HAFlag]
+		}
 		listItem["vip"] = v.Vip
 		listItem["vport"] = v.Vport
 		listItem["used_storage"] = v.UsedStorage
 		listItem["status"] = v.Status
 		listItem["ro_flag"] = v.ROFlag
+		listItem["pay_mode"] = v.PayMode
+		listItem["renew_flag"] = v.RenewFlag
+		listItem["end_time"] = v.EndTime
+		listItem["uid"] = v.Uid
+		listItem["tags"] = respTags
 
 		if *v.PayMode == 1 {
 			listItem["charge_type"] = COMMON_PAYTYPE_PREPAID
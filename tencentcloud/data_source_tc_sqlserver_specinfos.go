@@ -0,0 +1,173 @@
+/*
+Use this data source to query purchasable specifications of SQL Server instances.
+
+Example Usage
+
+```hcl
+data "tencentcloud_sqlserver_specinfos" "foo" {
+  availability_zone = "ap-guangzhou-2"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudSqlserverSpecinfos() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudSqlserverSpecinfosRead,
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Alphabet ID of availability zone. If not set, specifications of all the availability zones in current region are returned.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to store results.",
+			},
+			// Computed values
+			"specinfo_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of SQL Server specifications. Each element contains the following attributes:",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Alphabet ID of availability zone where the specification is purchasable.",
+						},
+						"spec_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Instance specification ID.",
+						},
+						"machine_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Model ID.",
+						},
+						"db_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Database version information. Valid values: `2008R2 (SQL Server 2008 Enterprise)`, `2012SP3 (SQL Server 2012 Enterprise)`, `2016SP1 (SQL Server 2016 Enterprise)`, `201602 (SQL Server 2016 Standard)`, `2017 (SQL Server 2017 Enterprise)`.",
+						},
+						"db_version_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version name corresponding to the `db_version` field.",
+						},
+						"memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Memory size in GB.",
+						},
+						"cpu": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of CPU cores.",
+						},
+						"min_storage_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minimum disk size under this specification in GB.",
+						},
+						"max_storage_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum disk size under this specification in GB.",
+						},
+						"qps": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "QPS of this specification.",
+						},
+						"pid": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Price ID of this specification under prepaid charge type.",
+						},
+						"charge_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Billing mode under this specification. Valid values are `POSTPAID_BY_HOUR`, `PREPAID` and `ALL` which means both POSTPAID_BY_HOUR and PREPAID.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudSqlserverSpecinfosRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_sqlserver_specinfos.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	var zones []string
+	if zone, ok := d.GetOk("availability_zone"); ok && zone.(string) != "" {
+		zones = append(zones, zone.(string))
+	} else {
+		zoneInfoList, err := sqlserverService.DescribeZones(ctx)
+		if err != nil {
+			return fmt.Errorf("api[DescribeZones]fail, return %s", err.Error())
+		}
+		for _, zoneInfo := range zoneInfoList {
+			zones = append(zones, *zoneInfo.Zone)
+		}
+	}
+
+	var specinfoList []map[string]interface{}
+	var ids = make([]string, 0, len(zones))
+	for _, zone := range zones {
+		specinfos, err := sqlserverService.DescribeProductConfig(ctx, zone)
+		if err != nil {
+			return fmt.Errorf("api[DescribeProductConfig]fail, return %s", err.Error())
+		}
+		for _, specinfo := range specinfos {
+			mapping := map[string]interface{}{
+				"availability_zone": zone,
+				"spec_id":           specinfo.SpecId,
+				"machine_type":      specinfo.MachineType,
+				"db_version":        specinfo.Version,
+				"db_version_name":   specinfo.VersionName,
+				"memory":            specinfo.Memory,
+				"cpu":               specinfo.CPU,
+				"min_storage_size":  specinfo.MinStorage,
+				"max_storage_size":  specinfo.MaxStorage,
+				"qps":               specinfo.QPS,
+				"pid":               specinfo.Pid,
+				"charge_type":       SQLSERVER_CHARGE_TYPE_NAME[*specinfo.PayModeStatus],
+			}
+			specinfoList = append(specinfoList, mapping)
+			ids = append(ids, zone+FILED_SP+strconv.FormatInt(*specinfo.SpecId, 10))
+		}
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if e := d.Set("specinfo_list", specinfoList); e != nil {
+		log.Printf("[CRITAL]%s provider set specinfo_list fail, reason:%s\n", logId, e.Error())
+		return e
+	}
+
+	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), specinfoList); err != nil {
+			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
+				logId, output.(string), err.Error())
+		}
+	}
+	return nil
+}
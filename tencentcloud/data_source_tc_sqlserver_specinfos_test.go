@@ -0,0 +1,36 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceTencentCloudSqlserverSpecinfos_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTencentCloudSqlserverSpecinfos,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTencentCloudDataSourceID("data.tencentcloud_sqlserver_specinfos.foo"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_sqlserver_specinfos.foo", "specinfo_list.0.spec_id"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_sqlserver_specinfos.foo", "specinfo_list.0.machine_type"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_sqlserver_specinfos.foo", "specinfo_list.0.db_version"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_sqlserver_specinfos.foo", "specinfo_list.0.memory"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_sqlserver_specinfos.foo", "specinfo_list.0.min_storage_size"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_sqlserver_specinfos.foo", "specinfo_list.0.max_storage_size"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_sqlserver_specinfos.foo", "specinfo_list.0.qps"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_sqlserver_specinfos.foo", "specinfo_list.0.pid"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceTencentCloudSqlserverSpecinfos = `
+data "tencentcloud_sqlserver_specinfos" "foo" {
+  availability_zone = "ap-guangzhou-2"
+}
+`
@@ -22,6 +22,11 @@ func dataSourceTencentSqlserverZoneConfig() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceTencentSqlserverZoneConfigRead,
 		Schema: map[string]*schema.Schema{
+			"db_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Database version to filter with. Valid values: `2008R2 (SQL Server 2008 Enterprise)`, `2012SP3 (SQL Server 2012 Enterprise)`, `2016SP1 (SQL Server 2016 Enterprise)`, `201602 (SQL Server 2016 Standard)`, `2017 (SQL Server 2017 Enterprise)`. When set, only availability zones that sell this version are returned.",
+			},
 			"result_output_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -43,6 +48,11 @@ func dataSourceTencentSqlserverZoneConfig() *schema.Resource {
 							Computed:    true,
 							Description: "Number ID of availability zone.",
 						},
+						"zone_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of availability zone.",
+						},
 						"specinfo_list": {
 							Type:        schema.TypeList,
 							Computed:    true,
@@ -117,6 +127,8 @@ func dataSourceTencentSqlserverZoneConfigRead(d *schema.ResourceData, meta inter
 
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 
+	dbVersion := d.Get("db_version").(string)
+
 	// get zoneinfo
 	zoneInfoList, err := sqlserverService.DescribeZones(ctx)
 	if err != nil {
@@ -124,8 +136,9 @@ func dataSourceTencentSqlserverZoneConfigRead(d *schema.ResourceData, meta inter
 	}
 	zoneSet := make(map[string]map[string]interface{})
 	for _, zoneInfo := range zoneInfoList {
-		zoneSetInfo := make(map[string]interface{}, 1)
+		zoneSetInfo := make(map[string]interface{}, 2)
 		zoneSetInfo["id"] = zoneInfo.ZoneId
+		zoneSetInfo["name"] = zoneInfo.ZoneName
 		zoneSet[*zoneInfo.Zone] = zoneSetInfo
 	}
 
@@ -134,12 +147,28 @@ func dataSourceTencentSqlserverZoneConfigRead(d *schema.ResourceData, meta inter
 		var zoneListItem = make(map[string]interface{})
 		zoneListItem["availability_zone"] = k
 		zoneListItem["zone_id"] = v["id"]
+		zoneListItem["zone_name"] = v["name"]
 
 		// get specinfo for each zone
 		specinfoList, err := sqlserverService.DescribeProductConfig(ctx, k)
 		if err != nil {
 			return fmt.Errorf("api[DescribeProductConfig]fail, return %s", err.Error())
 		}
+
+		// when db_version is set, drop zones that do not sell this version
+		if dbVersion != "" {
+			versionSupported := false
+			for _, specinfoItem := range specinfoList {
+				if *specinfoItem.Version == dbVersion {
+					versionSupported = true
+					break
+				}
+			}
+			if !versionSupported {
+				continue
+			}
+		}
+
 		var specinfoConfigs []interface{}
 		for _, specinfoItem := range specinfoList {
 			var specinfoConfig = make(map[string]interface{})
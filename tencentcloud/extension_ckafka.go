@@ -3,8 +3,21 @@ package tencentcloud
 const (
 	CKAFKA_DESCRIBE_LIMIT    = 50
 	CKAFKA_ACL_PRINCIPAL_STR = "User:"
+	CKAFKA_ACL_PRINCIPAL_ALL = "*"
+
+	CKAFKA_TOPIC_IP_WHITE_LIST_OP_ADD    = 1
+	CKAFKA_TOPIC_IP_WHITE_LIST_OP_REMOVE = 2
+
+	// CKAFKA_PAGINATION_MAX_PAGES caps how many pages one describe loop may fetch.
+	CKAFKA_PAGINATION_MAX_PAGES = 100
 )
 
+var CKAFKA_TOPIC_CLEAN_UP_POLICY = []string{
+	"delete",
+	"compact",
+	"compact, delete",
+}
+
 var CKAFKA_ACL_RESOURCE_TYPE = map[string]int64{
 	"UNKNOWN":          0,
 	"ANY":              1,
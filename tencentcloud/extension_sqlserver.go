@@ -11,6 +11,19 @@ var SQLSERVER_CHARGE_TYPE_NAME = map[string]string{
 	"ALL":  "ALL",
 }
 
+// instance statuses as reported by DescribeDBInstances
+const (
+	SQLSERVER_STATUS_APPLYING  = 1
+	SQLSERVER_STATUS_RUNNING   = 2
+	SQLSERVER_STATUS_ISOLATED  = 4
+	SQLSERVER_STATUS_RECYCLING = 5
+	SQLSERVER_STATUS_RECYCLED  = 6
+	SQLSERVER_STATUS_OFFLINE   = 8
+	SQLSERVER_STATUS_EXPANDING = 9
+	SQLSERVER_STATUS_MIGRATING = 10
+	SQLSERVER_STATUS_REBOOTING = 12
+)
+
 const (
 	SQLSERVER_TASK_SUCCESS = 0
 	SQLSERVER_TASK_FAIL    = 1
@@ -31,6 +44,14 @@ const (
 	SQLSERVER_ACCOUNT_RO = "ReadOnly"
 )
 
+const (
+	SQLSERVER_ACCOUNT_DELETING  = -1
+	SQLSERVER_ACCOUNT_CREATING  = 1
+	SQLSERVER_ACCOUNT_RUNNING   = 2
+	SQLSERVER_ACCOUNT_MODIFYING = 3
+	SQLSERVER_ACCOUNT_RESETTING = 4
+)
+
 var SQLSERVER_ACCOUNT_PRIVILEGE = []string{
 	SQLSERVER_ACCOUNT_RW,
 	SQLSERVER_ACCOUNT_RO,
@@ -41,6 +62,9 @@ const (
 	SQLSERVER_DEFAULT_OFFSET = 0
 )
 
+// SQLSERVER_PAGINATION_MAX_PAGES caps how many pages one describe loop may fetch.
+const SQLSERVER_PAGINATION_MAX_PAGES = 100
+
 const (
 	SQLSERVER_DB_CREATING  = 1
 	SQLSERVER_DB_RUNNING   = 2
@@ -71,3 +95,5 @@ const (
 	BASIC_NETWORK = 0
 	VPC_NEWORK    = 1
 )
+
+const SQLSERVER_MONITOR_NAMESPACE = "QCE/SQLSERVER"
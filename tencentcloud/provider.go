@@ -81,10 +81,15 @@ Ckafka
   Data Source
     tencentcloud_ckafka_users
     tencentcloud_ckafka_acls
+    tencentcloud_ckafka_consumer_groups
+    tencentcloud_ckafka_group_offsets
+    tencentcloud_ckafka_instances
+    tencentcloud_ckafka_topic_subscribe_group
 
   Resource
     tencentcloud_ckafka_user
     tencentcloud_ckafka_acl
+    tencentcloud_ckafka_topic
 
 Cloud Access Management(CAM)
   Data Source
@@ -333,6 +338,7 @@ SQLServer
 	tencentcloud_sqlserver_account_db_attachments
 	tencentcloud_sqlserver_backups
   	tencentcloud_sqlserver_readonly_groups
+	tencentcloud_sqlserver_specinfos
 
   Resource
 	tencentcloud_sqlserver_instance
@@ -517,6 +523,12 @@ func Provider() terraform.ResourceProvider {
 					},
 				},
 			},
+			"rate_limit": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "A map of per-second API request budgets keyed by service file name (e.g. `service_tencentcloud_ckafka`) or `file_name.Action` (e.g. `service_tencentcloud_sqlserver.CreateDBInstances`), overriding the built-in rate limits.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -634,8 +646,13 @@ func Provider() terraform.ResourceProvider {
 			"tencentcloud_sqlserver_accounts":               dataSourceTencentCloudSqlserverAccounts(),
 			"tencentcloud_sqlserver_account_db_attachments": dataSourceTencentCloudSqlserverAccountDBAttachments(),
 			"tencentcloud_sqlserver_readonly_groups":        dataSourceTencentCloudSqlserverReadonlyGroups(),
+			"tencentcloud_sqlserver_specinfos":              dataSourceTencentCloudSqlserverSpecinfos(),
 			"tencentcloud_ckafka_users":                     dataSourceTencentCloudCkafkaUsers(),
 			"tencentcloud_ckafka_acls":                      dataSourceTencentCloudCkafkaAcls(),
+			"tencentcloud_ckafka_consumer_groups":           dataSourceTencentCloudCkafkaConsumerGroups(),
+			"tencentcloud_ckafka_group_offsets":             dataSourceTencentCloudCkafkaGroupOffsets(),
+			"tencentcloud_ckafka_instances":                 dataSourceTencentCloudCkafkaInstances(),
+			"tencentcloud_ckafka_topic_subscribe_group":     dataSourceTencentCloudCkafkaTopicSubscribeGroup(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -757,6 +774,7 @@ func Provider() terraform.ResourceProvider {
 			"tencentcloud_sqlserver_readonly_instance":     resourceTencentCloudSqlserverReadonlyInstance(),
 			"tencentcloud_ckafka_user":                     resourceTencentCloudCkafkaUser(),
 			"tencentcloud_ckafka_acl":                      resourceTencentCloudCkafkaAcl(),
+			"tencentcloud_ckafka_topic":                    resourceTencentCloudCkafkaTopic(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -771,6 +789,13 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	protocol := d.Get("protocol").(string)
 	domain := d.Get("domain").(string)
 
+	// apply rate limit overrides before the first API call is issued
+	if v, ok := d.GetOk("rate_limit"); ok {
+		for key, limit := range v.(map[string]interface{}) {
+			ratelimit.SetLimit(key, int64(limit.(int)))
+		}
+	}
+
 	// standard client
 	var tcClient TencentCloudClient
 	tcClient.apiV3Conn = &connectivity.TencentCloudClient{
@@ -71,3 +71,18 @@ func Check(action string) {
 
 	ProCheck(fileName, action)
 }
+
+// SetLimit overrides the per-second budget for one key. The key is either a
+// namespace (e.g. `service_tencentcloud_ckafka`) or `namespace.action`
+// (e.g. `service_tencentcloud_sqlserver.CreateDBInstances`). Any limiter
+// already built for the key is dropped so the new budget takes effect on the
+// next Check. Non-positive limits are ignored.
+func SetLimit(key string, limit int64) {
+	if limit <= 0 {
+		return
+	}
+	locker.Lock()
+	limitConfig[key] = limit
+	delete(limitContainer, key)
+	locker.Unlock()
+}
@@ -116,6 +116,18 @@ func resourceTencentCloudCkafkaAclCreate(d *schema.ResourceData, meta interface{
 	host := d.Get("host").(string)
 	principal := d.Get("principal").(string)
 
+	// the resource id joins these fields with FILED_SP, a separator inside
+	// any of them would make the id ambiguous on import
+	for field, value := range map[string]string{
+		"resource_name": resourceName,
+		"host":          host,
+		"principal":     principal,
+	} {
+		if strings.Contains(value, FILED_SP) {
+			return fmt.Errorf("`%s` can not contain `%s`, which is reserved as the id separator", field, FILED_SP)
+		}
+	}
+
 	ckafkaService := CkafkaService{
 		client: meta.(*TencentCloudClient).apiV3Conn,
 	}
@@ -0,0 +1,302 @@
+/*
+Provides a resource to create a Ckafka topic.
+
+Example Usage
+
+Ckafka Topic
+
+```hcl
+resource "tencentcloud_ckafka_topic" "foo" {
+  instance_id       = "ckafka-f9ife4zz"
+  topic_name        = "topic-tf-test"
+  partition_num     = 2
+  replica_num       = 2
+  retention         = 60000
+  clean_up_policy   = "delete"
+  enable_white_list = false
+}
+```
+
+Import
+
+Ckafka topic can be imported using the instance_id#topic_name, e.g.
+
+```
+$ terraform import tencentcloud_ckafka_topic.foo ckafka-f9ife4zz#topic-tf-test
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func resourceTencentCloudCkafkaTopic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudCkafkaTopicCreate,
+		Read:   resourceTencentCloudCkafkaTopicRead,
+		Update: resourceTencentCloudCkafkaTopicUpdate,
+		Delete: resourceTencentCloudCkafkaTopicDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Id of the ckafka instance.",
+			},
+			"topic_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringLengthInRange(1, 64),
+				Description:  "Name of the topic, which is a string of no more than 64 characters. It must start with a letter, and the remaining part can contain letters, numbers and dashes (-).",
+			},
+			"partition_num": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The number of partitions, must be greater than 0. Partitions can only be increased.",
+			},
+			"replica_num": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(1, 3),
+				Description:  "The number of replicas, cannot be more than the number of brokers, maximum is 3.",
+			},
+			"retention": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Message retention time in ms, the current minimum value is 60000 ms.",
+			},
+			"clean_up_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "delete",
+				ValidateFunc: validateAllowedStringValue([]string{"delete", "compact", "compact, delete"}),
+				Description:  "Clear log policy. `delete`: logs are deleted according to the retention time, `compact`: logs are compacted according to the key, `compact, delete`: logs are compacted according to the key and deleted according to the retention time. Default is `delete`.",
+			},
+			"enable_white_list": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to open the ip white list. Default is false.",
+			},
+			"ip_white_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Ip white list, required when `enable_white_list` is true.",
+			},
+			"min_insync_replicas": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(1, 3),
+				Description:  "Minimum number of in-sync replicas, cannot be more than `replica_num`.",
+			},
+			"max_message_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(1, 8388608),
+				Description:  "Maximum size of a single message in bytes, the maximum value is 8388608 bytes (8MB).",
+			},
+			"segment_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Segment rolling time in ms, the current minimum value is 3600000 ms.",
+			},
+			// computed
+			"topic_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the topic.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudCkafkaTopicCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_ckafka_topic.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+
+	instanceId := d.Get("instance_id").(string)
+	topicName := d.Get("topic_name").(string)
+	partitionNum := int64(d.Get("partition_num").(int))
+	replicaNum := int64(d.Get("replica_num").(int))
+	cleanUpPolicy := d.Get("clean_up_policy").(string)
+	retention := int64(d.Get("retention").(int))
+	minInsyncReplicas := int64(d.Get("min_insync_replicas").(int))
+	maxMessageBytes := int64(d.Get("max_message_bytes").(int))
+	segmentMs := int64(d.Get("segment_ms").(int))
+
+	if minInsyncReplicas > replicaNum {
+		return fmt.Errorf("min_insync_replicas(%d) can not be more than replica_num(%d)", minInsyncReplicas, replicaNum)
+	}
+
+	var enableWhiteList int64
+	ipWhiteList := make([]*string, 0)
+	if d.Get("enable_white_list").(bool) {
+		enableWhiteList = 1
+		for _, v := range d.Get("ip_white_list").([]interface{}) {
+			ipWhiteList = append(ipWhiteList, helper.String(v.(string)))
+		}
+		if len(ipWhiteList) == 0 {
+			return fmt.Errorf("ip_white_list must be set when enable_white_list is true")
+		}
+	}
+
+	ckafkaService := CkafkaService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+	if err := ckafkaService.CreateCkafkaTopic(ctx, instanceId, topicName, partitionNum, replicaNum, enableWhiteList, ipWhiteList, cleanUpPolicy, retention, minInsyncReplicas, segmentMs); err != nil {
+		return fmt.Errorf("[CRITAL]%s create ckafka topic failed, reason:%+v", logId, err)
+	}
+	d.SetId(instanceId + FILED_SP + topicName)
+
+	// the CreateTopic api does not accept MaxMessageBytes, apply it with a follow-up modify
+	if maxMessageBytes > 0 {
+		if err := ckafkaService.ModifyCkafkaTopicAttribute(ctx, instanceId, topicName, enableWhiteList, 0, "", 0, maxMessageBytes, 0); err != nil {
+			return fmt.Errorf("[CRITAL]%s set max message bytes of ckafka topic failed, reason:%+v", logId, err)
+		}
+	}
+
+	return resourceTencentCloudCkafkaTopicRead(d, meta)
+}
+
+func resourceTencentCloudCkafkaTopicRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_ckafka_topic.read")()
+	defer inconsistentCheck(d, meta)()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ckafkaService := CkafkaService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	id := d.Id()
+	info, has, err := ckafkaService.DescribeTopicById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !has {
+		d.SetId("")
+		return nil
+	}
+	items := strings.Split(id, FILED_SP)
+	_ = d.Set("instance_id", items[0])
+	_ = d.Set("topic_name", items[1])
+	_ = d.Set("partition_num", info.PartitionNum)
+	_ = d.Set("enable_white_list", info.EnableWhiteList != nil && *info.EnableWhiteList == 1)
+	_ = d.Set("ip_white_list", helper.StringsInterfaces(info.IpWhiteList))
+	_ = d.Set("topic_id", info.TopicId)
+	if info.Config != nil {
+		_ = d.Set("retention", info.Config.Retention)
+		_ = d.Set("clean_up_policy", info.Config.CleanUpPolicy)
+		_ = d.Set("min_insync_replicas", info.Config.MinInsyncReplicas)
+		_ = d.Set("max_message_bytes", info.Config.MaxMessageBytes)
+		_ = d.Set("segment_ms", info.Config.SegmentMs)
+	}
+
+	return nil
+}
+
+func resourceTencentCloudCkafkaTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_ckafka_topic.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ckafkaService := CkafkaService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	topicName := d.Get("topic_name").(string)
+	if d.HasChange("partition_num") {
+		oldNum, newNum := d.GetChange("partition_num")
+		if newNum.(int) <= oldNum.(int) {
+			return fmt.Errorf("partition_num can only be increased, current is %d", oldNum.(int))
+		}
+		if err := ckafkaService.AddCkafkaTopicPartition(ctx, instanceId, topicName, int64(newNum.(int))); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("enable_white_list") || d.HasChange("retention") || d.HasChange("clean_up_policy") ||
+		d.HasChange("min_insync_replicas") || d.HasChange("max_message_bytes") || d.HasChange("segment_ms") {
+		var enableWhiteList int64
+		if d.Get("enable_white_list").(bool) {
+			enableWhiteList = 1
+		}
+		retention := int64(d.Get("retention").(int))
+		cleanUpPolicy := d.Get("clean_up_policy").(string)
+		minInsyncReplicas := int64(d.Get("min_insync_replicas").(int))
+		maxMessageBytes := int64(d.Get("max_message_bytes").(int))
+		segmentMs := int64(d.Get("segment_ms").(int))
+		if minInsyncReplicas > int64(d.Get("replica_num").(int)) {
+			return fmt.Errorf("min_insync_replicas(%d) can not be more than replica_num(%d)", minInsyncReplicas, d.Get("replica_num").(int))
+		}
+		if err := ckafkaService.ModifyCkafkaTopicAttribute(ctx, instanceId, topicName, enableWhiteList, retention, cleanUpPolicy, minInsyncReplicas, maxMessageBytes, segmentMs); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("ip_white_list") {
+		oldList, newList := d.GetChange("ip_white_list")
+		oldIps := make(map[string]bool)
+		for _, v := range oldList.([]interface{}) {
+			oldIps[v.(string)] = true
+		}
+		addIps := make([]string, 0)
+		for _, v := range newList.([]interface{}) {
+			ip := v.(string)
+			if oldIps[ip] {
+				delete(oldIps, ip)
+			} else {
+				addIps = append(addIps, ip)
+			}
+		}
+		removeIps := make([]string, 0, len(oldIps))
+		for ip := range oldIps {
+			removeIps = append(removeIps, ip)
+		}
+		if len(addIps) > 0 {
+			if err := ckafkaService.ModifyCkafkaTopicIpWhiteList(ctx, instanceId, topicName, addIps, CKAFKA_TOPIC_IP_WHITE_LIST_OP_ADD); err != nil {
+				return err
+			}
+		}
+		if len(removeIps) > 0 {
+			if err := ckafkaService.ModifyCkafkaTopicIpWhiteList(ctx, instanceId, topicName, removeIps, CKAFKA_TOPIC_IP_WHITE_LIST_OP_REMOVE); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceTencentCloudCkafkaTopicRead(d, meta)
+}
+
+func resourceTencentCloudCkafkaTopicDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_ckafka_topic.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ckafkaService := CkafkaService{
+		client: meta.(*TencentCloudClient).apiV3Conn,
+	}
+
+	if err := ckafkaService.DeleteCkafkaTopic(ctx, d.Id()); err != nil {
+		return err
+	}
+	return nil
+}
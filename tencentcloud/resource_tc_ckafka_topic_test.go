@@ -0,0 +1,121 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTencentCloudCkafkaTopic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCkafkaTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCkafkaTopic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCkafkaTopicExists("tencentcloud_ckafka_topic.foo"),
+					resource.TestCheckResourceAttr("tencentcloud_ckafka_topic.foo", "topic_name", "topic-tf-test"),
+					resource.TestCheckResourceAttr("tencentcloud_ckafka_topic.foo", "partition_num", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_ckafka_topic.foo", "replica_num", "2"),
+					resource.TestCheckResourceAttr("tencentcloud_ckafka_topic.foo", "clean_up_policy", "delete"),
+					resource.TestCheckResourceAttr("tencentcloud_ckafka_topic.foo", "enable_white_list", "false"),
+					resource.TestCheckResourceAttrSet("tencentcloud_ckafka_topic.foo", "instance_id"),
+					resource.TestCheckResourceAttrSet("tencentcloud_ckafka_topic.foo", "topic_id"),
+				),
+			},
+			{
+				Config: testAccCkafkaTopic_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCkafkaTopicExists("tencentcloud_ckafka_topic.foo"),
+					resource.TestCheckResourceAttr("tencentcloud_ckafka_topic.foo", "topic_name", "topic-tf-test"),
+					resource.TestCheckResourceAttr("tencentcloud_ckafka_topic.foo", "retention", "120000"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_ckafka_topic.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCkafkaTopicExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logId := getLogId(contextNil)
+		ctx := context.WithValue(context.TODO(), logIdKey, logId)
+		ckafkaService := CkafkaService{
+			client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
+		}
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("ckafka topic %s is not found", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ckafka topic id is not set")
+		}
+
+		_, has, err := ckafkaService.DescribeTopicById(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return fmt.Errorf("ckafka topic doesn't exist: %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckCkafkaTopicDestroy(s *terraform.State) error {
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	ckafkaService := CkafkaService{
+		client: testAccProvider.Meta().(*TencentCloudClient).apiV3Conn,
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tencentcloud_ckafka_topic" {
+			continue
+		}
+
+		_, has, err := ckafkaService.DescribeTopicById(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return nil
+		}
+		return fmt.Errorf("ckafka topic still exists: %s", rs.Primary.ID)
+	}
+	return nil
+}
+
+const testAccCkafkaTopic = `
+resource "tencentcloud_ckafka_topic" "foo" {
+  instance_id       = "ckafka-f9ife4zz"
+  topic_name        = "topic-tf-test"
+  partition_num     = 2
+  replica_num       = 2
+  retention         = 60000
+  clean_up_policy   = "delete"
+  enable_white_list = false
+}
+`
+
+const testAccCkafkaTopic_update = `
+resource "tencentcloud_ckafka_topic" "foo" {
+  instance_id       = "ckafka-f9ife4zz"
+  topic_name        = "topic-tf-test"
+  partition_num     = 2
+  replica_num       = 2
+  retention         = 120000
+  clean_up_policy   = "delete"
+  enable_white_list = false
+}
+`
@@ -47,6 +47,25 @@ func TestAccTencentCloudCkafkaUser(t *testing.T) {
 	})
 }
 
+func TestAccTencentCloudCkafkaUser_prefix(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCkafkaUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCkafkaUser_prefix,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCkafkaUserExists("tencentcloud_ckafka_user.prefix"),
+					testAccCheckCkafkaUserExists("tencentcloud_ckafka_user.prefix2"),
+					resource.TestCheckResourceAttr("tencentcloud_ckafka_user.prefix", "account_name", "tf-prefix"),
+					resource.TestCheckResourceAttr("tencentcloud_ckafka_user.prefix2", "account_name", "tf-prefix2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCkafkaUserExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		logId := getLogId(contextNil)
@@ -113,3 +132,17 @@ resource "tencentcloud_ckafka_user" "foo" {
   password     = "test1234update"
 }
 `
+
+const testAccCkafkaUser_prefix = `
+resource "tencentcloud_ckafka_user" "prefix" {
+  instance_id  = "ckafka-f9ife4zz"
+  account_name = "tf-prefix"
+  password     = "test1234"
+}
+
+resource "tencentcloud_ckafka_user" "prefix2" {
+  instance_id  = "ckafka-f9ife4zz"
+  account_name = "tf-prefix2"
+  password     = "test1234"
+}
+`
@@ -224,7 +224,8 @@ func resourceTencentCloudSqlserverAccountRead(d *schema.ResourceData, meta inter
 
 	_ = d.Set("instance_id", instanceId)
 	_ = d.Set("name", account.Name)
-	if *account.Remark == "--" {
+	// the API reports an unset remark as "--"
+	if account.Remark == nil || *account.Remark == "--" {
 		_ = d.Set("remark", "")
 	} else {
 		_ = d.Set("remark", account.Remark)
@@ -206,7 +206,7 @@ func resourceTencentCloudSqlserverAccountDBAttachmentRead(d *schema.ResourceData
 	_ = d.Set("instance_id", instanceId)
 	_ = d.Set("account_name", accountName)
 	_ = d.Set("db_name", dbName)
-	_ = d.Set("privilege", attachment["privilege"])
+	_ = d.Set("privilege", attachment.Privilege)
 
 	return nil
 }
@@ -50,7 +50,6 @@ func resourceTencentCloudSqlserverDB() *schema.Resource {
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "Name of SQL Server DB. The DataBase name must be unique and must be composed of numbers, letters and underlines, and the first one can not be underline.",
 			},
 			"charset": {
@@ -65,6 +64,12 @@ func resourceTencentCloudSqlserverDB() *schema.Resource {
 				Optional:    true,
 				Description: "Remark of the DB.",
 			},
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate whether the destroy waits for active connections to drain before dropping the database. Default is `false`, in which case a database in use fails the destroy with a clear message.",
+			},
 			// Computed
 			"create_time": {
 				Type:        schema.TypeString,
@@ -149,6 +154,15 @@ func resourceTencentCloudSqlserverDBUpdate(d *schema.ResourceData, meta interfac
 	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
 	instanceId := d.Get("instance_id").(string)
 
+	if d.HasChange("name") {
+		oldName, newName := d.GetChange("name")
+		if err := sqlserverService.RenameSqlserverDB(ctx, instanceId, oldName.(string), newName.(string)); err != nil {
+			return err
+		}
+		// the DB name is part of the resource ID, refresh it after rename
+		d.SetId(instanceId + FILED_SP + newName.(string))
+	}
+
 	if d.HasChange("remark") {
 		if err := sqlserverService.ModifySqlserverDBRemark(ctx, instanceId, d.Get("name").(string), d.Get("remark").(string)); err != nil {
 			return err
@@ -185,5 +199,5 @@ func resourceTencentCloudSqlserverDBDelete(d *schema.ResourceData, meta interfac
 		return nil
 	}
 
-	return sqlserverService.DeleteSqlserverDB(ctx, instanceId, name)
+	return sqlserverService.DeleteSqlserverDB(ctx, instanceId, name, d.Get("force_delete").(bool))
 }
@@ -80,6 +80,12 @@ func TencentSqlServerBasicInfo() map[string]*schema.Schema {
 			Computed:    true,
 			Description: "Availability zone.",
 		},
+		"region": {
+			Type:        schema.TypeString,
+			ForceNew:    true,
+			Optional:    true,
+			Description: "Region of the SQL Server instance, e.g. `ap-shanghai`. Defaults to the provider region, set it to manage instances across regions without a provider alias.",
+		},
 		"security_groups": {
 			Type:     schema.TypeSet,
 			Optional: true,
@@ -126,6 +132,12 @@ func resourceTencentCloudSqlserverInstance() *schema.Resource {
 			Default:     false,
 			Description: "Indicate whether to deploy across availability zones.",
 		},
+		"force_delete": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Indicate that the SQL Server instance is removed immediately when destroyed. Set to `false` to move the instance into the recycle bin instead, so it can still be recovered before the retention expires. Default is `true`.",
+		},
 		//RO computed values
 		"engine_version": {
 			Type:        schema.TypeString,
@@ -179,17 +191,39 @@ func resourceTencentCloudSqlserverInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Schema: specialInfo,
+		CustomizeDiff: resourceTencentCloudSqlserverInstanceSpecCheck,
+		Schema:        specialInfo,
 	}
 }
 
+// resourceTencentCloudSqlserverInstanceSpecCheck rejects memory/storage combinations the zone
+// does not sell at plan time, so the mistake surfaces before a billable deal is opened.
+func resourceTencentCloudSqlserverInstanceSpecCheck(d *schema.ResourceDiff, meta interface{}) error {
+	zone, _ := d.Get("availability_zone").(string)
+	memory := d.Get("memory").(int)
+	storage := d.Get("storage").(int)
+	// the zone may still be unknown when it is chosen by the server
+	if zone == "" || memory == 0 || storage == 0 {
+		return nil
+	}
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+	if v, ok := d.GetOk("region"); ok {
+		sqlserverService.region = v.(string)
+	}
+
+	return sqlserverService.ValidateSqlserverInstanceSpec(ctx, zone, memory, storage, 0)
+}
+
 func resourceTencentCloudSqlserverInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("resource.tencentcloud_sqlserver_instance.create")()
 
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+	sqlserverService := newSqlserverService(d, meta)
 
 	var (
 		name           = d.Get("name").(string)
@@ -256,7 +290,7 @@ func resourceTencentCloudSqlserverInstanceCreate(d *schema.ResourceData, meta in
 }
 
 func sqlServerAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+	sqlserverService := newSqlserverService(d, meta)
 	instanceId := d.Id()
 
 	var outErr, inErr error
@@ -350,7 +384,7 @@ func resourceTencentCloudSqlserverInstanceUpdate(d *schema.ResourceData, meta in
 	var outErr, inErr error
 	instanceId := d.Id()
 
-	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+	sqlserverService := newSqlserverService(d, meta)
 	//update project id
 	if d.HasChange("project_id") {
 		projectId := d.Get("project_id").(int)
@@ -400,30 +434,31 @@ func resourceTencentCloudSqlserverInstanceUpdate(d *schema.ResourceData, meta in
 	return resourceTencentCloudSqlserverInstanceRead(d, meta)
 }
 func tencentSqlServerBasicInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (instance *sqlserver.DBInstance,
-	has bool, errRet error) {
+	errRet error) {
 
 	if d.Id() == "" {
+		errRet = errSqlserverInstanceNotFound
 		return
 	}
 	instanceId := d.Id()
-	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+	sqlserverService := newSqlserverService(d, meta)
 
 	var outErr, inErr error
-	instance, has, outErr = sqlserverService.DescribeSqlserverInstanceById(ctx, d.Id())
-	if outErr != nil {
+	instance, outErr = sqlserverService.DescribeRunningSqlserverInstanceById(ctx, d.Id())
+	if outErr != nil && outErr != errSqlserverInstanceNotFound {
 		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			instance, has, inErr = sqlserverService.DescribeSqlserverInstanceById(ctx, instanceId)
-			if inErr != nil {
+			instance, inErr = sqlserverService.DescribeRunningSqlserverInstanceById(ctx, instanceId)
+			if inErr != nil && inErr != errSqlserverInstanceNotFound {
 				return retryError(inErr)
 			}
 			return nil
 		})
+		if outErr == nil {
+			outErr = inErr
+		}
 	}
 	if outErr != nil {
 		errRet = outErr
-	}
-
-	if !has {
 		return
 	}
 	//basic info
@@ -472,16 +507,16 @@ func resourceTencentCloudSqlserverInstanceRead(d *schema.ResourceData, meta inte
 
 	var outErr, inErr error
 	instanceId := d.Id()
-	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+	sqlserverService := newSqlserverService(d, meta)
 
-	instance, has, err := tencentSqlServerBasicInfoRead(ctx, d, meta)
-	if err != nil {
-		return err
-	}
-	if !has {
+	instance, err := tencentSqlServerBasicInfoRead(ctx, d, meta)
+	if err == errSqlserverInstanceNotFound {
 		d.SetId("")
 		return nil
 	}
+	if err != nil {
+		return err
+	}
 	_ = d.Set("project_id", instance.ProjectId)
 	_ = d.Set("engine_version", instance.Version)
 	_ = d.Set("ha_type", SQLSERVER_HA_TYPE_FLAGS[*instance.HAFlag])
@@ -514,7 +549,8 @@ func resourceTencentCLoudSqlserverInstanceDelete(d *schema.ResourceData, meta in
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
 	instanceId := d.Id()
-	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+	forceDelete := d.Get("force_delete").(bool)
+	sqlserverService := newSqlserverService(d, meta)
 
 	var outErr, inErr error
 	var has bool
@@ -536,11 +572,11 @@ func resourceTencentCLoudSqlserverInstanceDelete(d *schema.ResourceData, meta in
 	}
 
 	//terminate sql instance
-	outErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId)
+	outErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId, true)
 
 	if outErr != nil {
 		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			inErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId)
+			inErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId, true)
 			if inErr != nil {
 				return retryError(inErr)
 			}
@@ -552,6 +588,21 @@ func resourceTencentCLoudSqlserverInstanceDelete(d *schema.ResourceData, meta in
 		return outErr
 	}
 
+	//keep the instance in the recycle bin, just wait until it is isolated
+	if !forceDelete {
+		return resource.Retry(readRetryTimeout, func() *resource.RetryError {
+			status, found, inErr := sqlserverService.DescribeSqlserverInstanceStatus(ctx, instanceId)
+			if inErr != nil {
+				return retryError(inErr)
+			}
+			if !found || sqlserverInstanceHasDropped(int64(status)) {
+				return nil
+			}
+			inErr = fmt.Errorf("terminate SQL Server instance %s, status %d is not isolated yet", instanceId, status)
+			return resource.RetryableError(inErr)
+		})
+	}
+
 	outErr = sqlserverService.DeleteSqlserverInstance(ctx, instanceId)
 
 	if outErr != nil {
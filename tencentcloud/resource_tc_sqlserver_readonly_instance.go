@@ -64,6 +64,16 @@ func resourceTencentCloudSqlserverReadonlyInstance() *schema.Resource {
 			Optional:    true,
 			Description: "ID of the readonly group that this instance belongs to. When `readonly_group_type` set value `3`, it must be set with valid value.",
 		},
+		"readonly_group_vip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "IP of the readonly group that this instance belongs to.",
+		},
+		"readonly_group_vport": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Port of the readonly group that this instance belongs to.",
+		},
 	}
 
 	basic := TencentSqlServerBasicInfo()
@@ -87,7 +97,7 @@ func resourceTencentCloudSqlserverReadonlyInstanceCreate(d *schema.ResourceData,
 	logId := getLogId(contextNil)
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
-	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+	sqlserverService := newSqlserverService(d, meta)
 
 	var (
 		name              = d.Get("name").(string)
@@ -159,25 +169,25 @@ func resourceTencentCloudSqlserverReadonlyInstanceRead(d *schema.ResourceData, m
 
 	var outErr, inErr error
 	instanceId := d.Id()
-	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+	sqlserverService := newSqlserverService(d, meta)
 
-	instance, has, err := tencentSqlServerBasicInfoRead(ctx, d, meta)
-	if err != nil {
-		return err
-	}
-	if !has {
+	instance, err := tencentSqlServerBasicInfoRead(ctx, d, meta)
+	if err == errSqlserverInstanceNotFound {
 		d.SetId("")
 		return nil
 	}
+	if err != nil {
+		return err
+	}
 	_ = d.Set("project_id", instance.ProjectId)
 	_ = d.Set("engine_version", instance.Version)
 	_ = d.Set("ha_type", SQLSERVER_HA_TYPE_FLAGS[*instance.HAFlag])
 
 	//readonly group ID
-	readonlyGroupId, masterInstanceId, outErr := sqlserverService.DescribeReadonlyGroupListByReadonlyInstanceId(ctx, instanceId)
+	readonlyGroupId, masterInstanceId, groupVip, groupVport, outErr := sqlserverService.DescribeReadonlyGroupListByReadonlyInstanceId(ctx, instanceId)
 	if outErr != nil {
 		outErr = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-			readonlyGroupId, masterInstanceId, inErr = sqlserverService.DescribeReadonlyGroupListByReadonlyInstanceId(ctx, instanceId)
+			readonlyGroupId, masterInstanceId, groupVip, groupVport, inErr = sqlserverService.DescribeReadonlyGroupListByReadonlyInstanceId(ctx, instanceId)
 			if inErr != nil {
 				return retryError(inErr)
 			}
@@ -189,6 +199,8 @@ func resourceTencentCloudSqlserverReadonlyInstanceRead(d *schema.ResourceData, m
 	}
 	_ = d.Set("master_instance_id", masterInstanceId)
 	_ = d.Set("readonly_group_id", readonlyGroupId)
+	_ = d.Set("readonly_group_vip", groupVip)
+	_ = d.Set("readonly_group_vport", groupVport)
 
 	return nil
 }
@@ -214,7 +226,7 @@ func resourceTencentCloudSqlserverReadonlyInstanceDelete(d *schema.ResourceData,
 	ctx := context.WithValue(context.TODO(), logIdKey, logId)
 
 	instanceId := d.Id()
-	sqlserverService := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+	sqlserverService := newSqlserverService(d, meta)
 
 	var outErr, inErr error
 	var has bool
@@ -236,11 +248,11 @@ func resourceTencentCloudSqlserverReadonlyInstanceDelete(d *schema.ResourceData,
 	}
 
 	//terminate sql instance
-	outErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId)
+	outErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId, true)
 
 	if outErr != nil {
 		outErr = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
-			inErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId)
+			inErr = sqlserverService.TerminateSqlserverInstance(ctx, instanceId, true)
 			if inErr != nil {
 				return retryError(inErr)
 			}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	ckafka "github.com/liucy1983/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
+	sdkErrors "github.com/liucy1983/tencentcloud-sdk-go/tencentcloud/common/errors"
+	"github.com/pkg/errors"
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/ratelimit"
@@ -19,6 +21,9 @@ type CkafkaService struct {
 
 func (me *CkafkaService) CreateUser(ctx context.Context, instanceId, user, password string) (errRet error) {
 	logId := getLogId(ctx)
+	if errRet = validatePassword(password, "ckafka"); errRet != nil {
+		return
+	}
 	request := ckafka.NewCreateUserRequest()
 	request.InstanceId = &instanceId
 	request.Name = &user
@@ -26,10 +31,11 @@ func (me *CkafkaService) CreateUser(ctx context.Context, instanceId, user, passw
 
 	var response *ckafka.CreateUserResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseCkafkaClient().CreateUser(request)
 		if err != nil {
-			return retryError(err)
+			return retryErrorWithAction(request.GetAction(), err)
 		}
 		return nil
 	})
@@ -37,24 +43,29 @@ func (me *CkafkaService) CreateUser(ctx context.Context, instanceId, user, passw
 	if err != nil {
 		return err
 	}
-	if response != nil && response.Response != nil && !me.OperateStatusCheck(ctx, response.Response.Result) {
-		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+	if response != nil && response.Response != nil {
+		if ok, returnCode, returnMessage := me.OperateStatusCheck(ctx, response.Response.Result); !ok {
+			return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], return code[%s], return message[%s], request id[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), returnCode, returnMessage, helper.PString(response.Response.RequestId))
+		}
 	}
 	return nil
 }
 
-func (me *CkafkaService) OperateStatusCheck(ctx context.Context, result *ckafka.JgwOperateResponse) (isSucceed bool) {
+func (me *CkafkaService) OperateStatusCheck(ctx context.Context, result *ckafka.JgwOperateResponse) (isSucceed bool, returnCode, returnMessage string) {
 	logId := getLogId(ctx)
 	if result == nil {
 		log.Printf("[CRITAL]%s OperateStatusCheck fail, result is nil", logId)
-		return false
+		return false, "", "result is nil"
 	}
 
-	if result != nil && *result.ReturnCode == "0" {
-		return true
-	} else {
-		return false
+	if result.ReturnCode != nil {
+		returnCode = *result.ReturnCode
 	}
+	if result.ReturnMessage != nil {
+		returnMessage = *result.ReturnMessage
+	}
+	isSucceed = returnCode == "0"
+	return
 }
 
 func (me *CkafkaService) DescribeUserByUserId(ctx context.Context, userId string) (userInfo *ckafka.User, has bool, errRet error) {
@@ -67,7 +78,10 @@ func (me *CkafkaService) DescribeUserByUserId(ctx context.Context, userId string
 	}
 	instanceId, user := items[0], items[1]
 
-	if _, has, _ = me.DescribeInstanceById(ctx, instanceId); !has {
+	if _, instanceHas, err := me.DescribeInstanceById(ctx, instanceId); err != nil {
+		errRet = err
+		return
+	} else if !instanceHas {
 		return
 	}
 
@@ -77,38 +91,57 @@ func (me *CkafkaService) DescribeUserByUserId(ctx context.Context, userId string
 
 	var response *ckafka.DescribeUserResponse
 	var err error
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		response, err = me.client.UseCkafkaClient().DescribeUser(request)
 		if err != nil {
-			return retryError(err)
+			return retryErrorWithAction(request.GetAction(), err)
 		}
 		return nil
 	})
 
 	if err != nil {
-		errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), err.Error())
 		return
 	}
 
 	if response != nil && response.Response != nil && response.Response.Result != nil && response.Response.Result.Users != nil {
-		if len(response.Response.Result.Users) < 1 {
-			has = false
-			return
-		} else if len(response.Response.Result.Users) > 1 {
-			errRet = fmt.Errorf("[CRITAL]%s dumplicated users found", logId)
-			return
+		// SearchWord is a fuzzy match, so "admin" may also return "admin2";
+		// only keep the row whose name equals the requested user
+		for _, v := range response.Response.Result.Users {
+			if v.Name != nil && *v.Name == user {
+				userInfo = v
+				has = true
+				return
+			}
 		}
-
-		userInfo = response.Response.Result.Users[0]
-		has = true
-		return
 	}
 
 	return
 }
 
+// ckafkaWrongPasswordErrorCodes are the exact codes ModifyPassword returns
+// when the old password does not match the one stored for the user.
+var ckafkaWrongPasswordErrorCodes = []string{
+	"InvalidParameterValue.WrongPassword",
+}
+
+// isCkafkaWrongPasswordError reports whether an API error code indicates the
+// old password sent to ModifyPassword was incorrect. Anything else falls
+// through so the original error text is preserved.
+func isCkafkaWrongPasswordError(code string) bool {
+	for _, wrongPasswordCode := range ckafkaWrongPasswordErrorCodes {
+		if code == wrongPasswordCode {
+			return true
+		}
+	}
+	return false
+}
+
 func (me *CkafkaService) ModifyPassword(ctx context.Context, instanceId, user, oldPasswd, newPasswd string) (errRet error) {
 	logId := getLogId(ctx)
+	if errRet = validatePassword(newPasswd, "ckafka"); errRet != nil {
+		return
+	}
 	request := ckafka.NewModifyPasswordRequest()
 	request.InstanceId = &instanceId
 	request.Name = &user
@@ -117,19 +150,28 @@ func (me *CkafkaService) ModifyPassword(ctx context.Context, instanceId, user, o
 
 	var response *ckafka.ModifyPasswordResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseCkafkaClient().ModifyPassword(request)
 		if err != nil {
-			return retryError(err)
+			return retryErrorWithAction(request.GetAction(), err)
 		}
 		return nil
 	})
 
 	if err != nil {
+		if sdkErr, ok := errors.Cause(err).(*sdkErrors.TencentCloudSDKError); ok && isCkafkaWrongPasswordError(sdkErr.Code) {
+			return fmt.Errorf("the old password of user %s is incorrect, please check the password argument", user)
+		}
 		return err
 	}
-	if response != nil && response.Response != nil && !me.OperateStatusCheck(ctx, response.Response.Result) {
-		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+	if response != nil && response.Response != nil {
+		if ok, returnCode, returnMessage := me.OperateStatusCheck(ctx, response.Response.Result); !ok {
+			if isCkafkaWrongPasswordError(returnCode) {
+				return fmt.Errorf("the old password of user %s is incorrect, please check the password argument", user)
+			}
+			return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], return code[%s], return message[%s], request id[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), returnCode, returnMessage, helper.PString(response.Response.RequestId))
+		}
 	}
 	return nil
 }
@@ -150,10 +192,11 @@ func (me *CkafkaService) DeleteUser(ctx context.Context, userId string) (errRet
 
 	var response *ckafka.DeleteUserResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseCkafkaClient().DeleteUser(request)
 		if err != nil {
-			return retryError(err)
+			return retryErrorWithAction(request.GetAction(), err)
 		}
 		return nil
 	})
@@ -161,8 +204,10 @@ func (me *CkafkaService) DeleteUser(ctx context.Context, userId string) (errRet
 	if err != nil {
 		return err
 	}
-	if response != nil && response.Response != nil && !me.OperateStatusCheck(ctx, response.Response.Result) {
-		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+	if response != nil && response.Response != nil {
+		if ok, returnCode, returnMessage := me.OperateStatusCheck(ctx, response.Response.Result); !ok {
+			return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], return code[%s], return message[%s], request id[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), returnCode, returnMessage, helper.PString(response.Response.RequestId))
+		}
 	}
 	return nil
 }
@@ -171,7 +216,10 @@ func (me *CkafkaService) DescribeUserByFilter(ctx context.Context, params map[st
 	logId := getLogId(ctx)
 
 	instanceId := params["instance_id"].(string)
-	if _, has, _ := me.DescribeInstanceById(ctx, instanceId); !has {
+	if _, has, err := me.DescribeInstanceById(ctx, instanceId); err != nil {
+		errRet = err
+		return
+	} else if !has {
 		return
 	}
 
@@ -186,29 +234,112 @@ func (me *CkafkaService) DescribeUserByFilter(ctx context.Context, params map[st
 	request.Offset = &offset
 
 	userInfos = make([]*ckafka.User, 0)
-	for {
+	errRet = paginate(ctx, pageSize, CKAFKA_PAGINATION_MAX_PAGES, request.GetAction(), func(pageOffset int64) (int, error) {
+		offset = pageOffset
 		var response *ckafka.DescribeUserResponse
 		var err error
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseCkafkaClient().DescribeUser(request)
 			if err != nil {
-				return retryError(err)
+				return retryErrorWithAction(request.GetAction(), err)
 			}
-			userInfos = append(userInfos, response.Response.Result.Users...)
 			return nil
 		})
 		if err != nil {
-			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
-			return
-		} else {
-			if len(response.Response.Result.Users) < CKAFKA_DESCRIBE_LIMIT {
-				break
-			} else {
-				offset += pageSize
+			return 0, fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), err.Error())
+		}
+		userInfos = append(userInfos, response.Response.Result.Users...)
+		return len(response.Response.Result.Users), nil
+	})
+	return
+}
+
+func (me *CkafkaService) DescribeCkafkaGroups(ctx context.Context, instanceId string, searchWord string) (groupInfos []*ckafka.DescribeGroup, errRet error) {
+	logId := getLogId(ctx)
+
+	if _, has, err := me.DescribeInstanceById(ctx, instanceId); err != nil {
+		errRet = err
+		return
+	} else if !has {
+		return
+	}
+
+	request := ckafka.NewDescribeGroupRequest()
+	var offset int64 = 0
+	var pageSize = int64(CKAFKA_DESCRIBE_LIMIT)
+	request.InstanceId = &instanceId
+	if searchWord != "" {
+		request.SearchWord = &searchWord
+	}
+	request.Limit = &pageSize
+	request.Offset = &offset
+
+	groupInfos = make([]*ckafka.DescribeGroup, 0)
+	errRet = paginate(ctx, pageSize, CKAFKA_PAGINATION_MAX_PAGES, request.GetAction(), func(pageOffset int64) (int, error) {
+		offset = pageOffset
+		var response *ckafka.DescribeGroupResponse
+		var err error
+		err = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check(request.GetAction())
+			response, err = me.client.UseCkafkaClient().DescribeGroup(request)
+			if err != nil {
+				return retryErrorWithAction(request.GetAction(), err)
 			}
+			return nil
+		})
+		if err != nil {
+			return 0, fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), err.Error())
 		}
+		groupInfos = append(groupInfos, response.Response.Result.GroupList...)
+		return len(response.Response.Result.GroupList), nil
+	})
+	return
+}
+
+func (me *CkafkaService) DescribeCkafkaGroupOffsets(ctx context.Context, instanceId, group string, topics []string) (offsetTopics []*ckafka.GroupOffsetTopic, errRet error) {
+	logId := getLogId(ctx)
+
+	if _, has, err := me.DescribeInstanceById(ctx, instanceId); err != nil {
+		errRet = err
+		return
+	} else if !has {
+		return
 	}
+
+	request := ckafka.NewDescribeGroupOffsetsRequest()
+	var offset int64 = 0
+	var pageSize = int64(CKAFKA_DESCRIBE_LIMIT)
+	request.InstanceId = &instanceId
+	request.Group = &group
+	if len(topics) > 0 {
+		request.Topics = make([]*string, 0, len(topics))
+		for _, topic := range topics {
+			request.Topics = append(request.Topics, helper.String(topic))
+		}
+	}
+	request.Limit = &pageSize
+	request.Offset = &offset
+
+	offsetTopics = make([]*ckafka.GroupOffsetTopic, 0)
+	errRet = paginate(ctx, pageSize, CKAFKA_PAGINATION_MAX_PAGES, request.GetAction(), func(pageOffset int64) (int, error) {
+		offset = pageOffset
+		var response *ckafka.DescribeGroupOffsetsResponse
+		var err error
+		err = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check(request.GetAction())
+			response, err = me.client.UseCkafkaClient().DescribeGroupOffsets(request)
+			if err != nil {
+				return retryErrorWithAction(request.GetAction(), err)
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), err.Error())
+		}
+		offsetTopics = append(offsetTopics, response.Response.Result.TopicList...)
+		return len(response.Response.Result.TopicList), nil
+	})
 	return
 }
 
@@ -221,14 +352,20 @@ func (me *CkafkaService) CreateAcl(ctx context.Context, instanceId, resourceType
 	request.Operation = helper.Int64(CKAFKA_ACL_OPERATION[operation])
 	request.PermissionType = helper.Int64(CKAFKA_PERMISSION_TYPE[permissionType])
 	request.Host = &host
-	request.Principal = helper.String(CKAFKA_ACL_PRINCIPAL_STR + principal)
+	if principal == CKAFKA_ACL_PRINCIPAL_ALL {
+		// the wildcard principal must be sent as-is, "User:*" is rejected
+		request.Principal = &principal
+	} else {
+		request.Principal = helper.String(CKAFKA_ACL_PRINCIPAL_STR + principal)
+	}
 
 	var response *ckafka.CreateAclResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseCkafkaClient().CreateAcl(request)
 		if err != nil {
-			return retryError(err)
+			return retryErrorWithAction(request.GetAction(), err)
 		}
 		return nil
 	})
@@ -236,17 +373,69 @@ func (me *CkafkaService) CreateAcl(ctx context.Context, instanceId, resourceType
 	if err != nil {
 		return err
 	}
-	if response != nil && response.Response != nil && !me.OperateStatusCheck(ctx, response.Response.Result) {
-		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+	if response != nil && response.Response != nil {
+		if ok, returnCode, returnMessage := me.OperateStatusCheck(ctx, response.Response.Result); !ok {
+			return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], return code[%s], return message[%s], request id[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), returnCode, returnMessage, helper.PString(response.Response.RequestId))
+		}
 	}
 	return nil
 }
 
-func (me *CkafkaService) DescribeAclByFilter(ctx context.Context, params map[string]interface{}) (aclInfos []*ckafka.Acl, errRet error) {
+// CkafkaAclSpec describes a single ACL entry for batch operations.
+type CkafkaAclSpec struct {
+	ResourceType   string
+	ResourceName   string
+	Operation      string
+	PermissionType string
+	Host           string
+	Principal      string
+}
+
+func (me *CkafkaService) aclId(instanceId string, spec CkafkaAclSpec) string {
+	return instanceId + FILED_SP + spec.PermissionType + FILED_SP + spec.Principal + FILED_SP +
+		spec.Host + FILED_SP + spec.Operation + FILED_SP + spec.ResourceType + FILED_SP + spec.ResourceName
+}
+
+func (me *CkafkaService) CreateCkafkaAcls(ctx context.Context, instanceId string, acls []CkafkaAclSpec) (errRet error) {
+	logId := getLogId(ctx)
+
+	created := make([]CkafkaAclSpec, 0, len(acls))
+	for _, spec := range acls {
+		if err := me.CreateAcl(ctx, instanceId, spec.ResourceType, spec.ResourceName, spec.Operation, spec.PermissionType, spec.Host, spec.Principal); err != nil {
+			// roll back the entries already created so the batch stays all-or-nothing
+			for _, rollback := range created {
+				if e := me.DeleteAcl(ctx, me.aclId(instanceId, rollback)); e != nil {
+					log.Printf("[CRITAL]%s rollback of acl %s failed, reason:%s\n", logId, me.aclId(instanceId, rollback), e.Error())
+				}
+			}
+			errRet = fmt.Errorf("create acl for principal %s on %s %s failed, %d acls rolled back, reason:%s",
+				spec.Principal, spec.ResourceType, spec.ResourceName, len(created), err.Error())
+			return
+		}
+		created = append(created, spec)
+	}
+	return nil
+}
+
+func (me *CkafkaService) DeleteCkafkaAcls(ctx context.Context, instanceId string, acls []CkafkaAclSpec) (errRet error) {
+	for _, spec := range acls {
+		if err := me.DeleteAcl(ctx, me.aclId(instanceId, spec)); err != nil {
+			errRet = fmt.Errorf("delete acl for principal %s on %s %s failed, reason:%s",
+				spec.Principal, spec.ResourceType, spec.ResourceName, err.Error())
+			return
+		}
+	}
+	return nil
+}
+
+func (me *CkafkaService) DescribeAclByFilter(ctx context.Context, params map[string]interface{}) (aclInfos []*ckafka.Acl, totalCount int64, errRet error) {
 	logId := getLogId(ctx)
 
 	instanceId := params["instance_id"].(string)
-	if _, has, _ := me.DescribeInstanceById(ctx, instanceId); !has {
+	if _, has, err := me.DescribeInstanceById(ctx, instanceId); err != nil {
+		errRet = err
+		return
+	} else if !has {
 		return
 	}
 	resourceType := params["resource_type"].(string)
@@ -269,30 +458,41 @@ func (me *CkafkaService) DescribeAclByFilter(ctx context.Context, params map[str
 	request.Limit = &pageSize
 	request.Offset = &offset
 
+	// the API cannot filter by operation or permission type, apply them here
+	// so callers don't have to post-filter
+	operationFilter, filterOperation := params["operation_type"]
+	permissionFilter, filterPermission := params["permission_type"]
+
 	aclInfos = make([]*ckafka.Acl, 0)
-	for {
+	errRet = paginate(ctx, pageSize, CKAFKA_PAGINATION_MAX_PAGES, request.GetAction(), func(pageOffset int64) (int, error) {
+		offset = pageOffset
 		var response *ckafka.DescribeACLResponse
 		var err error
-		err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseCkafkaClient().DescribeACL(request)
 			if err != nil {
-				return retryError(err)
+				return retryErrorWithAction(request.GetAction(), err)
 			}
-			aclInfos = append(aclInfos, response.Response.Result.AclList...)
 			return nil
 		})
 		if err != nil {
-			errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
-			return
-		} else {
-			if len(response.Response.Result.AclList) < CKAFKA_DESCRIBE_LIMIT {
-				break
-			} else {
-				offset += pageSize
+			return 0, fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), err.Error())
+		}
+		for _, acl := range response.Response.Result.AclList {
+			if filterOperation && CKAFKA_ACL_OPERATION_TO_STRING[*acl.Operation] != operationFilter.(string) {
+				continue
+			}
+			if filterPermission && CKAFKA_PERMISSION_TYPE_TO_STRING[*acl.PermissionType] != permissionFilter.(string) {
+				continue
 			}
+			aclInfos = append(aclInfos, acl)
 		}
-	}
+		if response.Response.Result.TotalCount != nil {
+			totalCount = *response.Response.Result.TotalCount
+		}
+		return len(response.Response.Result.AclList), nil
+	})
 	return
 }
 
@@ -300,7 +500,8 @@ func (me *CkafkaService) DescribeAclByAclId(ctx context.Context, aclId string) (
 	// acl id is organized by "instanceId + FILED_SP + permissionType + FILED_SP + principal + FILED_SP + host + FILED_SP + operation + FILED_SP + resourceType + FILED_SP + resourceName"
 	items := strings.Split(aclId, FILED_SP)
 	if len(items) != 7 {
-		errRet = fmt.Errorf("id of resource.tencentcloud_ckafka_acl is wrong")
+		errRet = fmt.Errorf("id of resource.tencentcloud_ckafka_acl is wrong, expect instance_id%spermission_type%sprincipal%shost%soperation_type%sresource_type%sresource_name with exactly 7 fields",
+			FILED_SP, FILED_SP, FILED_SP, FILED_SP, FILED_SP, FILED_SP)
 		return
 	}
 	instanceId, permission, principal, host, operation, resourceType, resourceName := items[0], items[1], items[2], items[3], items[4], items[5], items[6]
@@ -311,13 +512,16 @@ func (me *CkafkaService) DescribeAclByAclId(ctx context.Context, aclId string) (
 		"resource_name": resourceName,
 		"host":          host,
 	}
-	aclInfos, err := me.DescribeAclByFilter(ctx, params)
+	aclInfos, _, err := me.DescribeAclByFilter(ctx, params)
 	if err != nil {
 		errRet = err
 		return
 	}
 	for _, acl := range aclInfos {
-		if CKAFKA_PERMISSION_TYPE_TO_STRING[*acl.PermissionType] == permission && *acl.Principal == CKAFKA_ACL_PRINCIPAL_STR+principal && CKAFKA_ACL_OPERATION_TO_STRING[*acl.Operation] == operation {
+		// the wildcard principal may come back either bare or with the "User:" prefix
+		principalMatched := *acl.Principal == CKAFKA_ACL_PRINCIPAL_STR+principal ||
+			(principal == CKAFKA_ACL_PRINCIPAL_ALL && *acl.Principal == CKAFKA_ACL_PRINCIPAL_ALL)
+		if CKAFKA_PERMISSION_TYPE_TO_STRING[*acl.PermissionType] == permission && principalMatched && CKAFKA_ACL_OPERATION_TO_STRING[*acl.Operation] == operation {
 			aclInfo = acl
 			has = true
 			return
@@ -333,7 +537,8 @@ func (me *CkafkaService) DeleteAcl(ctx context.Context, aclId string) (errRet er
 	// acl id is organized by "instanceId + FILED_SP + permissionType + FILED_SP + principal + FILED_SP + host + FILED_SP + operation + FILED_SP + resourceType + FILED_SP + resourceName"
 	items := strings.Split(aclId, FILED_SP)
 	if len(items) != 7 {
-		errRet = fmt.Errorf("id of resource.tencentcloud_ckafka_acl is wrong")
+		errRet = fmt.Errorf("id of resource.tencentcloud_ckafka_acl is wrong, expect instance_id%spermission_type%sprincipal%shost%soperation_type%sresource_type%sresource_name with exactly 7 fields",
+			FILED_SP, FILED_SP, FILED_SP, FILED_SP, FILED_SP, FILED_SP)
 		return
 	}
 	instanceId, permission, principal, host, operation, resourceType, resourceName := items[0], items[1], items[2], items[3], items[4], items[5], items[6]
@@ -345,14 +550,19 @@ func (me *CkafkaService) DeleteAcl(ctx context.Context, aclId string) (errRet er
 	request.Operation = helper.Int64(CKAFKA_ACL_OPERATION[operation])
 	request.PermissionType = helper.Int64(CKAFKA_PERMISSION_TYPE[permission])
 	request.Host = &host
-	request.Principal = helper.String(CKAFKA_ACL_PRINCIPAL_STR + principal)
+	if principal == CKAFKA_ACL_PRINCIPAL_ALL {
+		request.Principal = &principal
+	} else {
+		request.Principal = helper.String(CKAFKA_ACL_PRINCIPAL_STR + principal)
+	}
 
 	var response *ckafka.DeleteAclResponse
 	var err error
-	err = resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseCkafkaClient().DeleteAcl(request)
 		if err != nil {
-			return retryError(err)
+			return retryErrorWithAction(request.GetAction(), err)
 		}
 		return nil
 	})
@@ -360,8 +570,10 @@ func (me *CkafkaService) DeleteAcl(ctx context.Context, aclId string) (errRet er
 	if err != nil {
 		return err
 	}
-	if response != nil && response.Response != nil && !me.OperateStatusCheck(ctx, response.Response.Result) {
-		return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+	if response != nil && response.Response != nil {
+		if ok, returnCode, returnMessage := me.OperateStatusCheck(ctx, response.Response.Result); !ok {
+			return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], return code[%s], return message[%s], request id[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), returnCode, returnMessage, helper.PString(response.Response.RequestId))
+		}
 	}
 	return nil
 }
@@ -373,16 +585,19 @@ func (me *CkafkaService) DescribeInstanceById(ctx context.Context, instanceId st
 	request.InstanceId = &instanceId
 	var response *ckafka.DescribeInstanceAttributesResponse
 	var err error
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseCkafkaClient().DescribeInstanceAttributes(request)
 		if err != nil {
-			return retryError(err)
+			return retryErrorWithAction(request.GetAction(), err)
 		}
 		return nil
 	})
 	if err != nil {
-		errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		if isNotFoundError(err) {
+			return
+		}
+		errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), err.Error())
 		return
 	}
 
@@ -397,6 +612,465 @@ func (me *CkafkaService) DescribeInstanceById(ctx context.Context, instanceId st
 	return
 }
 
+func (me *CkafkaService) DescribeCkafkaInstances(ctx context.Context, instanceId, searchWord string, status []int64) (instanceList []*ckafka.Instance, errRet error) {
+	logId := getLogId(ctx)
+
+	request := ckafka.NewDescribeInstancesRequest()
+	var offset int64 = 0
+	var pageSize = int64(CKAFKA_DESCRIBE_LIMIT)
+	if instanceId != "" {
+		request.InstanceId = &instanceId
+	}
+	if searchWord != "" {
+		request.SearchWord = &searchWord
+	}
+	if len(status) > 0 {
+		request.Status = make([]*int64, 0, len(status))
+		for _, v := range status {
+			request.Status = append(request.Status, helper.Int64(v))
+		}
+	}
+	request.Limit = &pageSize
+	request.Offset = &offset
+
+	instanceList = make([]*ckafka.Instance, 0)
+	errRet = paginate(ctx, pageSize, CKAFKA_PAGINATION_MAX_PAGES, request.GetAction(), func(pageOffset int64) (int, error) {
+		offset = pageOffset
+		var response *ckafka.DescribeInstancesResponse
+		var err error
+		err = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check(request.GetAction())
+			response, err = me.client.UseCkafkaClient().DescribeInstances(request)
+			if err != nil {
+				return retryErrorWithAction(request.GetAction(), err)
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), err.Error())
+		}
+		instanceList = append(instanceList, response.Response.Result.InstanceList...)
+		return len(response.Response.Result.InstanceList), nil
+	})
+	return
+}
+
+func (me *CkafkaService) DescribeCkafkaTopicSubscribeGroup(ctx context.Context, instanceId, topicName string) (groups []*ckafka.ConsumerGroup, errRet error) {
+	logId := getLogId(ctx)
+
+	request := ckafka.NewDescribeConsumerGroupRequest()
+	var offset int64 = 0
+	var pageSize = int64(CKAFKA_DESCRIBE_LIMIT)
+	request.InstanceId = &instanceId
+	request.Limit = &pageSize
+	request.Offset = &offset
+
+	// the TopicName filter is ignored by the API unless a group is also
+	// given, so fetch the groups and filter by subscription here
+	groups = make([]*ckafka.ConsumerGroup, 0)
+	errRet = paginate(ctx, pageSize, CKAFKA_PAGINATION_MAX_PAGES, request.GetAction(), func(pageOffset int64) (int, error) {
+		offset = pageOffset
+		var response *ckafka.DescribeConsumerGroupResponse
+		var err error
+		err = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check(request.GetAction())
+			response, err = me.client.UseCkafkaClient().DescribeConsumerGroup(request)
+			if err != nil {
+				return retryErrorWithAction(request.GetAction(), err)
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), err.Error())
+		}
+		for _, group := range response.Response.Result.GroupList {
+			for _, info := range group.SubscribedInfo {
+				if info.TopicName != nil && *info.TopicName == topicName {
+					groups = append(groups, group)
+					break
+				}
+			}
+		}
+		return len(response.Response.Result.GroupList), nil
+	})
+	return
+}
+
+// WaitForCkafkaInstance retries a not-yet-visible instance, since describes
+// right after creation can miss it while the control plane catches up.
+func (me *CkafkaService) WaitForCkafkaInstance(ctx context.Context, instanceId string) (instanceInfo *ckafka.InstanceAttributesResponse, errRet error) {
+	errRet = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		info, has, err := me.DescribeInstanceById(ctx, instanceId)
+		if err != nil {
+			return retryError(err)
+		}
+		if !has {
+			return resource.RetryableError(fmt.Errorf("ckafka instance %s is not visible yet", instanceId))
+		}
+		instanceInfo = info
+		return nil
+	})
+	return
+}
+
+func (me *CkafkaService) ModifyCkafkaInstanceTags(ctx context.Context, instanceId string, replaceTags map[string]string, deleteKeys []string) (errRet error) {
+	tagService := TagService{client: me.client}
+	resourceName := BuildTagResourceName("ckafka", "ckafkaId", me.client.Region, instanceId)
+	return tagService.ModifyTags(ctx, resourceName, replaceTags, deleteKeys)
+}
+
+func (me *CkafkaService) DescribeCkafkaInstanceTags(ctx context.Context, instanceId string) (tags map[string]string, errRet error) {
+	instanceInfo, has, err := me.DescribeInstanceById(ctx, instanceId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if !has {
+		errRet = fmt.Errorf("ckafka instance %s doesn't exist", instanceId)
+		return
+	}
+	tags = make(map[string]string, len(instanceInfo.Tags))
+	for _, tag := range instanceInfo.Tags {
+		if tag.TagKey != nil && tag.TagValue != nil {
+			tags[*tag.TagKey] = *tag.TagValue
+		}
+	}
+	return
+}
+
+func (me *CkafkaService) DescribeCkafkaRoutes(ctx context.Context, instanceId string) (routes []*ckafka.Route, errRet error) {
+	logId := getLogId(ctx)
+
+	request := ckafka.NewDescribeRouteRequest()
+	request.InstanceId = &instanceId
+	var response *ckafka.DescribeRouteResponse
+	var err error
+	err = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		response, err = me.client.UseCkafkaClient().DescribeRoute(request)
+		if err != nil {
+			return retryErrorWithAction(request.GetAction(), err)
+		}
+		return nil
+	})
+	if err != nil {
+		errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), err.Error())
+		return
+	}
+
+	if response != nil && response.Response != nil && response.Response.Result != nil {
+		routes = response.Response.Result.Routers
+	}
+	return
+}
+
+func (me *CkafkaService) CreateCkafkaTopic(ctx context.Context, instanceId, topicName string, partitionNum, replicaNum, enableWhiteList int64, ipWhiteList []*string, cleanUpPolicy string, retentionMs, minInsyncReplicas, segmentMs int64) (errRet error) {
+	logId := getLogId(ctx)
+	request := ckafka.NewCreateTopicRequest()
+	request.InstanceId = &instanceId
+	request.TopicName = &topicName
+	request.PartitionNum = &partitionNum
+	request.ReplicaNum = &replicaNum
+	request.EnableWhiteList = &enableWhiteList
+	if enableWhiteList == 1 {
+		request.IpWhiteList = ipWhiteList
+	}
+	request.CleanUpPolicy = &cleanUpPolicy
+	if retentionMs > 0 {
+		request.RetentionMs = &retentionMs
+	}
+	if minInsyncReplicas > 0 {
+		request.MinInsyncReplicas = &minInsyncReplicas
+	}
+	if segmentMs > 0 {
+		request.SegmentMs = &segmentMs
+	}
+
+	var response *ckafka.CreateTopicResponse
+	var err error
+	err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		response, err = me.client.UseCkafkaClient().CreateTopic(request)
+		if err != nil {
+			return retryErrorWithAction(request.GetAction(), err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+	if response == nil || response.Response == nil || response.Response.Result == nil || response.Response.Result.TopicId == nil {
+		errMsg := fmt.Sprintf("[CRITAL]%s api[%s] fail, request body [%s], topic id is not returned", logId, request.GetAction(), redactRequestBody(request.ToJsonString()))
+		if response != nil && response.Response != nil {
+			errMsg = fmt.Sprintf("%s, request id[%s]", errMsg, helper.PString(response.Response.RequestId))
+		}
+		return fmt.Errorf("%s", errMsg)
+	}
+	return nil
+}
+
+func (me *CkafkaService) ModifyCkafkaTopicRetention(ctx context.Context, instanceId, topicName string, retentionMs int64, cleanUpPolicy string) (errRet error) {
+	if cleanUpPolicy != "" && !IsContains(CKAFKA_TOPIC_CLEAN_UP_POLICY, cleanUpPolicy) {
+		return fmt.Errorf("clean up policy %s is not supported, allowed values are %v", cleanUpPolicy, CKAFKA_TOPIC_CLEAN_UP_POLICY)
+	}
+
+	topicInfo, has, err := me.DescribeTopicById(ctx, instanceId+FILED_SP+topicName)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return fmt.Errorf("topic %s doesn't exist in instance %s", topicName, instanceId)
+	}
+	var enableWhiteList int64
+	if topicInfo.EnableWhiteList != nil {
+		enableWhiteList = *topicInfo.EnableWhiteList
+	}
+
+	if err := me.ModifyCkafkaTopicAttribute(ctx, instanceId, topicName, enableWhiteList, retentionMs, cleanUpPolicy, 0, 0, 0); err != nil {
+		return err
+	}
+
+	// the attribute modify is async on some broker versions, confirm it took effect
+	return retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		topicInfo, has, err := me.DescribeTopicById(ctx, instanceId+FILED_SP+topicName)
+		if err != nil {
+			return retryError(err)
+		}
+		if !has || topicInfo.Config == nil {
+			return resource.NonRetryableError(fmt.Errorf("topic %s disappeared while modifying retention", topicName))
+		}
+		if retentionMs > 0 && (topicInfo.Config.Retention == nil || *topicInfo.Config.Retention != retentionMs) {
+			return resource.RetryableError(fmt.Errorf("retention of topic %s is still being modified", topicName))
+		}
+		if cleanUpPolicy != "" && (topicInfo.Config.CleanUpPolicy == nil || *topicInfo.Config.CleanUpPolicy != cleanUpPolicy) {
+			return resource.RetryableError(fmt.Errorf("clean up policy of topic %s is still being modified", topicName))
+		}
+		return nil
+	})
+}
+
+func (me *CkafkaService) ModifyCkafkaTopicAttribute(ctx context.Context, instanceId, topicName string, enableWhiteList, retentionMs int64, cleanUpPolicy string, minInsyncReplicas, maxMessageBytes, segmentMs int64) (errRet error) {
+	logId := getLogId(ctx)
+	request := ckafka.NewModifyTopicAttributesRequest()
+	request.InstanceId = &instanceId
+	request.TopicName = &topicName
+	request.EnableWhiteList = &enableWhiteList
+	if retentionMs > 0 {
+		request.RetentionMs = &retentionMs
+	}
+	if cleanUpPolicy != "" {
+		request.CleanUpPolicy = &cleanUpPolicy
+	}
+	if minInsyncReplicas > 0 {
+		request.MinInsyncReplicas = &minInsyncReplicas
+	}
+	if maxMessageBytes > 0 {
+		request.MaxMessageBytes = &maxMessageBytes
+	}
+	if segmentMs > 0 {
+		request.SegmentMs = &segmentMs
+	}
+
+	var response *ckafka.ModifyTopicAttributesResponse
+	var err error
+	err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		response, err = me.client.UseCkafkaClient().ModifyTopicAttributes(request)
+		if err != nil {
+			return retryErrorWithAction(request.GetAction(), err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+	if response != nil && response.Response != nil {
+		if ok, returnCode, returnMessage := me.OperateStatusCheck(ctx, response.Response.Result); !ok {
+			return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], return code[%s], return message[%s], request id[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), returnCode, returnMessage, helper.PString(response.Response.RequestId))
+		}
+	}
+	return nil
+}
+
+func (me *CkafkaService) ModifyCkafkaTopicIpWhiteList(ctx context.Context, instanceId, topicName string, ipWhiteList []string, op int) (errRet error) {
+	logId := getLogId(ctx)
+
+	ips := make([]*string, 0, len(ipWhiteList))
+	for _, ip := range ipWhiteList {
+		ips = append(ips, helper.String(ip))
+	}
+
+	switch op {
+	case CKAFKA_TOPIC_IP_WHITE_LIST_OP_ADD:
+		request := ckafka.NewCreateTopicIpWhiteListRequest()
+		request.InstanceId = &instanceId
+		request.TopicName = &topicName
+		request.IpWhiteList = ips
+
+		var response *ckafka.CreateTopicIpWhiteListResponse
+		var err error
+		err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check(request.GetAction())
+			response, err = me.client.UseCkafkaClient().CreateTopicIpWhiteList(request)
+			if err != nil {
+				return retryErrorWithAction(request.GetAction(), err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if response != nil && response.Response != nil {
+			if ok, returnCode, returnMessage := me.OperateStatusCheck(ctx, response.Response.Result); !ok {
+				return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], return code[%s], return message[%s], request id[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), returnCode, returnMessage, helper.PString(response.Response.RequestId))
+			}
+		}
+	case CKAFKA_TOPIC_IP_WHITE_LIST_OP_REMOVE:
+		request := ckafka.NewDeleteTopicIpWhiteListRequest()
+		request.InstanceId = &instanceId
+		request.TopicName = &topicName
+		request.IpWhiteList = ips
+
+		var response *ckafka.DeleteTopicIpWhiteListResponse
+		var err error
+		err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check(request.GetAction())
+			response, err = me.client.UseCkafkaClient().DeleteTopicIpWhiteList(request)
+			if err != nil {
+				return retryErrorWithAction(request.GetAction(), err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if response != nil && response.Response != nil {
+			if ok, returnCode, returnMessage := me.OperateStatusCheck(ctx, response.Response.Result); !ok {
+				return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], return code[%s], return message[%s], request id[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), returnCode, returnMessage, helper.PString(response.Response.RequestId))
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported ip white list operation %d", op)
+	}
+	return nil
+}
+
+func (me *CkafkaService) DescribeCkafkaTopicIpWhiteList(ctx context.Context, instanceId, topicName string) (ipWhiteList []string, errRet error) {
+	topicInfo, has, err := me.DescribeTopicById(ctx, instanceId+FILED_SP+topicName)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if !has {
+		errRet = fmt.Errorf("topic %s doesn't exist in instance %s", topicName, instanceId)
+		return
+	}
+	ipWhiteList = make([]string, 0, len(topicInfo.IpWhiteList))
+	for _, ip := range topicInfo.IpWhiteList {
+		if ip != nil {
+			ipWhiteList = append(ipWhiteList, *ip)
+		}
+	}
+	return
+}
+
+// CheckCkafkaTopicWritable probes whether every partition of a topic has a
+// healthy leader, which is the closest liveness signal the API offers; there
+// is no produce-test or message-flow action in the SDK.
+func (me *CkafkaService) CheckCkafkaTopicWritable(ctx context.Context, instanceId, topicName string) (errRet error) {
+	topicInfo, has, err := me.DescribeTopicById(ctx, instanceId+FILED_SP+topicName)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return fmt.Errorf("topic %s doesn't exist in instance %s", topicName, instanceId)
+	}
+	for _, partition := range topicInfo.Partitions {
+		if partition.LeaderStatus != nil && *partition.LeaderStatus != 1 {
+			return fmt.Errorf("partition %d of topic %s has no healthy leader", *partition.Partition, topicName)
+		}
+	}
+	return nil
+}
+
+func (me *CkafkaService) AddCkafkaTopicPartition(ctx context.Context, instanceId, topicName string, partitionNum int64) (errRet error) {
+	logId := getLogId(ctx)
+
+	topicInfo, has, err := me.DescribeTopicById(ctx, instanceId+FILED_SP+topicName)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return fmt.Errorf("topic %s doesn't exist in instance %s", topicName, instanceId)
+	}
+	if topicInfo.PartitionNum != nil && partitionNum <= *topicInfo.PartitionNum {
+		return fmt.Errorf("partition number can only be increased, current is %d, requested %d", *topicInfo.PartitionNum, partitionNum)
+	}
+
+	request := ckafka.NewCreatePartitionRequest()
+	request.InstanceId = &instanceId
+	request.TopicName = &topicName
+	request.PartitionNum = &partitionNum
+
+	var response *ckafka.CreatePartitionResponse
+	err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		response, err = me.client.UseCkafkaClient().CreatePartition(request)
+		if err != nil {
+			return retryErrorWithAction(request.GetAction(), err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+	if response != nil && response.Response != nil {
+		if ok, returnCode, returnMessage := me.OperateStatusCheck(ctx, response.Response.Result); !ok {
+			return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], return code[%s], return message[%s], request id[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), returnCode, returnMessage, helper.PString(response.Response.RequestId))
+		}
+	}
+	return nil
+}
+
+func (me *CkafkaService) DeleteCkafkaTopic(ctx context.Context, topicId string) (errRet error) {
+	logId := getLogId(ctx)
+
+	items := strings.Split(topicId, FILED_SP)
+	if len(items) != 2 {
+		errRet = fmt.Errorf("id of resource.tencentcloud_ckafka_topic is wrong")
+		return
+	}
+	instanceId, topicName := items[0], items[1]
+
+	request := ckafka.NewDeleteTopicRequest()
+	request.InstanceId = &instanceId
+	request.TopicName = &topicName
+
+	var response *ckafka.DeleteTopicResponse
+	var err error
+	err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		response, err = me.client.UseCkafkaClient().DeleteTopic(request)
+		if err != nil {
+			return retryErrorWithAction(request.GetAction(), err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+	if response != nil && response.Response != nil {
+		if ok, returnCode, returnMessage := me.OperateStatusCheck(ctx, response.Response.Result); !ok {
+			return fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], return code[%s], return message[%s], request id[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), returnCode, returnMessage, helper.PString(response.Response.RequestId))
+		}
+	}
+	return nil
+}
+
 func (me *CkafkaService) DescribeTopicById(ctx context.Context, topicId string) (topicInfo *ckafka.TopicAttributesResponse, has bool, errRet error) {
 	logId := getLogId(ctx)
 
@@ -412,16 +1086,19 @@ func (me *CkafkaService) DescribeTopicById(ctx context.Context, topicId string)
 	request.TopicName = &topicName
 	var response *ckafka.DescribeTopicAttributesResponse
 	var err error
-	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		ratelimit.Check(request.GetAction())
 		response, err = me.client.UseCkafkaClient().DescribeTopicAttributes(request)
 		if err != nil {
-			return retryError(err)
+			return retryErrorWithAction(request.GetAction(), err)
 		}
 		return nil
 	})
 	if err != nil {
-		errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		if isNotFoundError(err) {
+			return
+		}
+		errRet = fmt.Errorf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]", logId, request.GetAction(), redactRequestBody(request.ToJsonString()), err.Error())
 		return
 	}
 
@@ -0,0 +1,56 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	ckafka "github.com/liucy1983/tencentcloud-sdk-go/tencentcloud/ckafka/v20190819"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func TestIsCkafkaWrongPasswordError(t *testing.T) {
+	tests := []struct {
+		code    string
+		matched bool
+	}{
+		{"InvalidParameterValue.WrongPassword", true},
+		// a rejected new password must keep its original error text
+		{"InvalidParameterValue.WrongPasswordNew", false},
+		{"InternalError", false},
+		{"", false},
+	}
+
+	for _, v := range tests {
+		if isCkafkaWrongPasswordError(v.code) != v.matched {
+			t.Errorf("Failed: %#v", v)
+		}
+	}
+}
+
+func TestOperateStatusCheck(t *testing.T) {
+	service := CkafkaService{}
+
+	tests := []struct {
+		result        *ckafka.JgwOperateResponse
+		isSucceed     bool
+		returnCode    string
+		returnMessage string
+	}{
+		{nil, false, "", "result is nil"},
+		{&ckafka.JgwOperateResponse{}, false, "", ""},
+		{&ckafka.JgwOperateResponse{
+			ReturnCode:    helper.String("0"),
+			ReturnMessage: helper.String("ok"),
+		}, true, "0", "ok"},
+		{&ckafka.JgwOperateResponse{
+			ReturnCode:    helper.String("500"),
+			ReturnMessage: helper.String("user already exists"),
+		}, false, "500", "user already exists"},
+	}
+
+	for _, v := range tests {
+		isSucceed, returnCode, returnMessage := service.OperateStatusCheck(contextNil, v.result)
+		if isSucceed != v.isSucceed || returnCode != v.returnCode || returnMessage != v.returnMessage {
+			t.Errorf("Failed: %#v, got (%v, %q, %q)", v, isSucceed, returnCode, returnMessage)
+		}
+	}
+}
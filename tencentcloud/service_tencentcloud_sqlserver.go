@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/pkg/errors"
-	SDKErrors "github.com/liucy1983/tencentcloud-sdk-go/tencentcloud/common/errors"
+	monitor "github.com/liucy1983/tencentcloud-sdk-go/tencentcloud/monitor/v20180724"
 	sqlserver "github.com/liucy1983/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
@@ -18,6 +20,96 @@ import (
 
 type SqlserverService struct {
 	client *connectivity.TencentCloudClient
+
+	// region routes the sqlserver API calls to another region than the
+	// provider's when set, for resources carrying a region override
+	region string
+
+	// request-scoped describe caches, keyed by instance ID. A SqlserverService
+	// value lives for a single resource operation, so entries never outlive
+	// one plan/apply step; write methods and polling loops invalidate through
+	// invalidateDescribeCache so they always observe fresh state.
+	instanceCache map[string]*sqlserver.DBInstance
+	dbCache       map[string][]*sqlserver.DBDetail
+}
+
+// sqlserverClient returns the sqlserver client of the service's region,
+// defaulting to the provider region.
+func (me *SqlserverService) sqlserverClient() *sqlserver.Client {
+	return me.client.UseSqlserverClientRegion(me.region)
+}
+
+// newSqlserverService builds a service routed through the resource's region
+// override when the schema carries one, defaulting to the provider region.
+func newSqlserverService(d *schema.ResourceData, meta interface{}) SqlserverService {
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+	if v, ok := d.GetOk("region"); ok {
+		service.region = v.(string)
+	}
+	return service
+}
+
+// invalidateDescribeCache drops the cached describe results of one instance,
+// called after every write that may change them and before each poll.
+func (me *SqlserverService) invalidateDescribeCache(instanceId string) {
+	delete(me.instanceCache, instanceId)
+	delete(me.dbCache, instanceId)
+}
+
+// errSqlserverInstanceNotFound is returned by DescribeRunningSqlserverInstanceById when the
+// instance is absent or already isolated/recycled/off-line, so callers can drop the resource
+// from state with a single error check instead of branching on a bool.
+var errSqlserverInstanceNotFound = errors.New("SQL Server instance not found")
+
+// sqlserverInstanceHasDropped indicates the instance can no longer serve
+// requests: isolated, recycled or off-line.
+func sqlserverInstanceHasDropped(status int64) bool {
+	switch status {
+	case SQLSERVER_STATUS_ISOLATED, SQLSERVER_STATUS_RECYCLED, SQLSERVER_STATUS_OFFLINE:
+		return true
+	}
+	return false
+}
+
+// sqlserverRetryError works like retryErrorWithAction but additionally backs off on
+// OperationDenied.* conflicts raised when another task (backup, upgrade, migration...) holds
+// the instance lock, since those settle by themselves once the concurrent operation finishes.
+func sqlserverRetryError(action string, err error) *resource.RetryError {
+	return retryErrorWithAction(action, err, "OperationDenied")
+}
+
+// sqlserverInstanceInTransition indicates the instance sits in a state that settles by itself
+// and is worth waiting on: applying, expanding, migrating or rebooting. Recycling is not one
+// of them, a recycling instance only ever ends up recycled.
+func sqlserverInstanceInTransition(status int64) bool {
+	switch status {
+	case SQLSERVER_STATUS_APPLYING, SQLSERVER_STATUS_EXPANDING,
+		SQLSERVER_STATUS_MIGRATING, SQLSERVER_STATUS_REBOOTING:
+		return true
+	}
+	return false
+}
+
+// WaitForSqlserverInstance blocks until the instance leaves all transitional states, so callers
+// never hand back an instance that is not yet usable. A not-yet-listed instance is treated as
+// retryable for the whole window, since the list API lags behind CreateDBInstances and a describe
+// issued right after create can transiently miss the new instance.
+func (me *SqlserverService) WaitForSqlserverInstance(ctx context.Context, instanceId string) (errRet error) {
+	errRet = retryWithContext(ctx, 4*writeRetryTimeout, func() *resource.RetryError {
+		me.invalidateDescribeCache(instanceId)
+		instance, has, err := me.DescribeSqlserverInstanceById(ctx, instanceId)
+		if err != nil {
+			return resource.NonRetryableError(errors.WithStack(err))
+		}
+		if !has {
+			return resource.RetryableError(fmt.Errorf("SQL Server instance %s is not listed yet.... ", instanceId))
+		}
+		if sqlserverInstanceInTransition(*instance.Status) {
+			return resource.RetryableError(fmt.Errorf("SQL Server instance ID %s is still in transitional status %d.... ", instanceId, *instance.Status))
+		}
+		return nil
+	})
+	return
 }
 
 func (me *SqlserverService) DescribeZones(ctx context.Context) (zoneInfoList []*sqlserver.ZoneInfo, errRet error) {
@@ -27,17 +119,17 @@ func (me *SqlserverService) DescribeZones(ctx context.Context) (zoneInfoList []*
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), redactRequestBody(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	var response *sqlserver.DescribeZonesResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseSqlserverClient().DescribeZones(request)
+		result, e := me.sqlserverClient().DescribeZones(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s DescribeZones fail, reason:%s\n", logId, e.Error())
-			return retryError(e)
+			return retryErrorWithAction(request.GetAction(), e)
 		}
 		response = result
 		return nil
@@ -52,6 +144,27 @@ func (me *SqlserverService) DescribeZones(ctx context.Context) (zoneInfoList []*
 	return
 }
 
+// resolveZoneName maps a numeric zone ID such as "200002" to the zone name
+// such as "ap-guangzhou-2". An ID the zone list does not carry resolves to "",
+// so callers can skip checks they cannot decide instead of failing on it.
+func (me *SqlserverService) resolveZoneName(ctx context.Context, zoneId string) (zone string, errRet error) {
+	zoneInfoList, err := me.DescribeZones(ctx)
+	if err != nil {
+		errRet = err
+		return
+	}
+	for _, zoneInfo := range zoneInfoList {
+		if zoneInfo == nil || zoneInfo.ZoneId == nil {
+			continue
+		}
+		if strconv.FormatInt(*zoneInfo.ZoneId, 10) == zoneId {
+			zone = helper.PString(zoneInfo.Zone)
+			return
+		}
+	}
+	return
+}
+
 func (me *SqlserverService) DescribeProductConfig(ctx context.Context, zone string) (specInfoList []*sqlserver.SpecInfo, errRet error) {
 	logId := getLogId(ctx)
 	request := sqlserver.NewDescribeProductConfigRequest()
@@ -60,17 +173,17 @@ func (me *SqlserverService) DescribeProductConfig(ctx context.Context, zone stri
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), redactRequestBody(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	var response *sqlserver.DescribeProductConfigResponse
-	err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	err := retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
 		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseSqlserverClient().DescribeProductConfig(request)
+		result, e := me.sqlserverClient().DescribeProductConfig(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s DescribeProductConfig fail, reason:%s\n", logId, e.Error())
-			return retryError(e)
+			return retryErrorWithAction(request.GetAction(), e)
 		}
 		response = result
 		return nil
@@ -85,6 +198,38 @@ func (me *SqlserverService) DescribeProductConfig(ctx context.Context, zone stri
 	return
 }
 
+// ValidateSqlserverInstanceSpec checks the requested memory/storage/cpu combination against
+// the specs the zone sells, so an invalid spec can fail at plan time instead of after a
+// billable deal has been opened. A zero cpu matches any core count.
+func (me *SqlserverService) ValidateSqlserverInstanceSpec(ctx context.Context, zone string, memory, storage, cpu int) error {
+	specInfoList, err := me.DescribeProductConfig(ctx, zone)
+	if err != nil {
+		return err
+	}
+
+	validSpecs := make([]string, 0, len(specInfoList))
+	seenSpecs := make(map[string]bool, len(specInfoList))
+	for _, specInfo := range specInfoList {
+		if specInfo.Memory == nil || specInfo.MinStorage == nil || specInfo.MaxStorage == nil {
+			continue
+		}
+		if cpu > 0 && specInfo.CPU != nil && int64(cpu) != *specInfo.CPU {
+			continue
+		}
+		if int64(memory) == *specInfo.Memory &&
+			int64(storage) >= *specInfo.MinStorage && int64(storage) <= *specInfo.MaxStorage {
+			return nil
+		}
+		spec := fmt.Sprintf("memory %dGB with storage %d-%dGB", *specInfo.Memory, *specInfo.MinStorage, *specInfo.MaxStorage)
+		if !seenSpecs[spec] {
+			seenSpecs[spec] = true
+			validSpecs = append(validSpecs, spec)
+		}
+	}
+	return fmt.Errorf("SQL Server spec with memory %dGB and storage %dGB is not purchasable in zone %s, valid specs are: %s",
+		memory, storage, zone, strings.Join(validSpecs, "; "))
+}
+
 func (me *SqlserverService) CreateSqlserverInstance(ctx context.Context, dbVersion string, chargeType string, memory int, autoRenewFlag int, projectId int, subnetId string, vpcId string, zone string, storage int, weekSet []int, startTime string, timeSpan int, multiZones bool, haType string, securityGroups []string) (instanceId string, errRet error) {
 	logId := getLogId(ctx)
 	request := sqlserver.NewCreateDBInstancesRequest()
@@ -128,8 +273,60 @@ func (me *SqlserverService) CreateSqlserverInstance(ctx context.Context, dbVersi
 	request.GoodsNum = helper.IntInt64(1)
 	request.Zone = &zone
 
+	// reject versions the zone cannot sell before placing the order
+	if zone != "" {
+		specInfoList, err := me.DescribeProductConfig(ctx, zone)
+		if err != nil {
+			errRet = err
+			return
+		}
+		supported := false
+		for _, specInfo := range specInfoList {
+			if specInfo.Version != nil && *specInfo.Version == dbVersion {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			errRet = fmt.Errorf("SQL Server version %s is not purchasable in zone %s, check the specinfos of the zone", dbVersion, zone)
+			return
+		}
+	}
+
+	// verify the subnet sits in the requested VPC and zone before the order is placed,
+	// a mismatch only surfaces as an opaque server-side error after the deal is opened
+	if vpcId != "" && subnetId != "" {
+		vpcService := VpcService{client: me.client}
+		subnetInfo, has, err := vpcService.DescribeSubnet(ctx, subnetId, nil, "", "")
+		if err != nil {
+			errRet = err
+			return
+		}
+		if has == 0 {
+			errRet = fmt.Errorf("subnet %s does not exist", subnetId)
+			return
+		}
+		if subnetInfo.vpcId != vpcId {
+			errRet = fmt.Errorf("subnet %s belongs to VPC %s instead of the requested VPC %s", subnetId, subnetInfo.vpcId, vpcId)
+			return
+		}
+		// the VPC API reports the zone as a numeric ID, resolve it to a name
+		// like "ap-guangzhou-2" before comparing with the requested zone
+		if zone != "" && subnetInfo.zone != "" {
+			subnetZone, err := me.resolveZoneName(ctx, subnetInfo.zone)
+			if err != nil {
+				errRet = err
+				return
+			}
+			if subnetZone != "" && subnetZone != zone {
+				errRet = fmt.Errorf("subnet %s sits in zone %s instead of the requested zone %s", subnetId, subnetZone, zone)
+				return
+			}
+		}
+	}
+
 	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().CreateDBInstances(request)
+	response, err := me.sqlserverClient().CreateDBInstances(request)
 	if err != nil {
 		errRet = err
 		return
@@ -139,22 +336,34 @@ func (me *SqlserverService) CreateSqlserverInstance(ctx context.Context, dbVersi
 		return
 	}
 	if len(response.Response.DealNames) == 0 {
-		errRet = fmt.Errorf("TencentCloud SDK returns empty SQL Server ID")
-		return
-	} else if len(response.Response.DealNames) > 1 {
-		errRet = fmt.Errorf("TencentCloud SDK returns more than one SQL Server ID")
+		errRet = fmt.Errorf("TencentCloud SDK returns empty SQL Server ID, request id %s", helper.PString(response.Response.RequestId))
 		return
 	}
 
-	dealId := *response.Response.DealNames[0]
-	instanceId, err = me.GetInfoFromDeal(ctx, dealId)
+	dealNames := make([]string, 0, len(response.Response.DealNames))
+	for _, v := range response.Response.DealNames {
+		dealNames = append(dealNames, *v)
+	}
+	instanceIds, err := me.GetInfoFromDeals(ctx, dealNames)
 	if err != nil {
 		errRet = err
+		return
 	}
+	if len(instanceIds) == 0 {
+		errRet = fmt.Errorf("TencentCloud SDK returns empty SQL Server ID")
+		return
+	} else if len(instanceIds) > 1 {
+		errRet = fmt.Errorf("TencentCloud SDK returns more than one SQL Server ID")
+		return
+	}
+	instanceId = instanceIds[0]
+
+	errRet = me.WaitForSqlserverInstance(ctx, instanceId)
 	return
 }
 
 func (me *SqlserverService) ModifySqlserverInstanceName(ctx context.Context, instanceId string, name string) (errRet error) {
+	defer me.invalidateDescribeCache(instanceId)
 	logId := getLogId(ctx)
 	request := sqlserver.NewModifyDBInstanceNameRequest()
 	request.InstanceId = &instanceId
@@ -166,14 +375,25 @@ func (me *SqlserverService) ModifySqlserverInstanceName(ctx context.Context, ins
 	}()
 
 	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().ModifyDBInstanceName(request)
+	_, err := me.sqlserverClient().ModifyDBInstanceName(request)
 	return err
 }
 
 func (me *SqlserverService) ModifySqlserverInstanceProjectId(ctx context.Context, instanceId string, projectId int) (errRet error) {
+	return me.ModifySqlserverInstancesProject(ctx, []string{instanceId}, projectId)
+}
+
+// ModifySqlserverInstancesProject moves a whole set of instances into a project with a single
+// ModifyDBInstanceProject call instead of one call per instance.
+func (me *SqlserverService) ModifySqlserverInstancesProject(ctx context.Context, instanceIds []string, projectId int) (errRet error) {
+	defer func() {
+		for _, id := range instanceIds {
+			me.invalidateDescribeCache(id)
+		}
+	}()
 	logId := getLogId(ctx)
 	request := sqlserver.NewModifyDBInstanceProjectRequest()
-	request.InstanceIdSet = []*string{&instanceId}
+	request.InstanceIdSet = helper.Strings(instanceIds)
 	request.ProjectId = helper.IntInt64(projectId)
 	defer func() {
 		if errRet != nil {
@@ -182,11 +402,12 @@ func (me *SqlserverService) ModifySqlserverInstanceProjectId(ctx context.Context
 	}()
 
 	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().ModifyDBInstanceProject(request)
+	_, err := me.sqlserverClient().ModifyDBInstanceProject(request)
 	return err
 }
 
 func (me *SqlserverService) UpgradeSqlserverInstance(ctx context.Context, instanceId string, memory int, storage int) (errRet error) {
+	defer me.invalidateDescribeCache(instanceId)
 	logId := getLogId(ctx)
 	request := sqlserver.NewUpgradeDBInstanceRequest()
 	request.InstanceId = &instanceId
@@ -198,14 +419,22 @@ func (me *SqlserverService) UpgradeSqlserverInstance(ctx context.Context, instan
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().UpgradeDBInstance(request)
+	err := retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, e := me.sqlserverClient().UpgradeDBInstance(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
+			return sqlserverRetryError(request.GetAction(), e)
+		}
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
 	//check status not expanding
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+	errRet = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		me.invalidateDescribeCache(instanceId)
 		instance, has, err := me.DescribeSqlserverInstanceById(ctx, instanceId)
 		if err != nil {
 			return resource.NonRetryableError(errors.WithStack(err))
@@ -213,7 +442,7 @@ func (me *SqlserverService) UpgradeSqlserverInstance(ctx context.Context, instan
 		if !has {
 			return resource.NonRetryableError(fmt.Errorf("cannot find SQL Server instance %s", instanceId))
 		}
-		if int(*instance.Status) == 9 {
+		if sqlserverInstanceInTransition(*instance.Status) {
 			return resource.RetryableError(fmt.Errorf("expanding , SQL Server instance ID %s, status %d.... ", instanceId, *instance.Status))
 		} else {
 			return nil
@@ -224,6 +453,7 @@ func (me *SqlserverService) UpgradeSqlserverInstance(ctx context.Context, instan
 }
 
 func (me *SqlserverService) RemoveSecurityGroup(ctx context.Context, instanceId string, securityGroupId string) (errRet error) {
+	defer me.invalidateDescribeCache(instanceId)
 	logId := getLogId(ctx)
 	request := sqlserver.NewDisassociateSecurityGroupsRequest()
 	request.InstanceIdSet = []*string{&instanceId}
@@ -236,12 +466,13 @@ func (me *SqlserverService) RemoveSecurityGroup(ctx context.Context, instanceId
 	}()
 
 	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().DisassociateSecurityGroups(request)
+	_, err := me.sqlserverClient().DisassociateSecurityGroups(request)
 
 	return err
 }
 
 func (me *SqlserverService) AddSecurityGroup(ctx context.Context, instanceId string, securityGroupId string) (errRet error) {
+	defer me.invalidateDescribeCache(instanceId)
 	logId := getLogId(ctx)
 	request := sqlserver.NewAssociateSecurityGroupsRequest()
 	request.InstanceIdSet = []*string{&instanceId}
@@ -254,12 +485,13 @@ func (me *SqlserverService) AddSecurityGroup(ctx context.Context, instanceId str
 	}()
 
 	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().AssociateSecurityGroups(request)
+	_, err := me.sqlserverClient().AssociateSecurityGroups(request)
 	time.Sleep(10 * time.Second)
 	return err
 }
 
 func (me *SqlserverService) ModifySqlserverInstanceMaintenanceSpan(ctx context.Context, instanceId string, weekSet []int, startTime string, timeSpan int) (errRet error) {
+	defer me.invalidateDescribeCache(instanceId)
 	logId := getLogId(ctx)
 	request := sqlserver.NewModifyMaintenanceSpanRequest()
 	request.InstanceId = &instanceId
@@ -282,12 +514,17 @@ func (me *SqlserverService) ModifySqlserverInstanceMaintenanceSpan(ctx context.C
 	}()
 
 	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().ModifyMaintenanceSpan(request)
+	_, err := me.sqlserverClient().ModifyMaintenanceSpan(request)
 
 	return err
 }
 
-func (me *SqlserverService) TerminateSqlserverInstance(ctx context.Context, instanceId string) (errRet error) {
+// TerminateSqlserverInstance fires TerminateDBInstance. With wait set it then polls
+// DescribeSqlserverInstanceStatus until the instance is isolated or no longer listed, so
+// destroy ordering does not race while the backend is still terminating; the wait window
+// follows writeRetryTimeout and is therefore tunable through TENCENTCLOUD_WRITE_RETRY_TIMEOUT.
+func (me *SqlserverService) TerminateSqlserverInstance(ctx context.Context, instanceId string, wait bool) (errRet error) {
+	defer me.invalidateDescribeCache(instanceId)
 	logId := getLogId(ctx)
 	request := sqlserver.NewTerminateDBInstanceRequest()
 	request.InstanceIdSet = []*string{&instanceId}
@@ -298,11 +535,27 @@ func (me *SqlserverService) TerminateSqlserverInstance(ctx context.Context, inst
 	}()
 
 	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().TerminateDBInstance(request)
-	return err
+	if _, err := me.sqlserverClient().TerminateDBInstance(request); err != nil {
+		return err
+	}
+	if !wait {
+		return nil
+	}
+
+	return retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		status, found, err := me.DescribeSqlserverInstanceStatus(ctx, instanceId)
+		if err != nil {
+			return retryError(err)
+		}
+		if !found || sqlserverInstanceHasDropped(int64(status)) {
+			return nil
+		}
+		return resource.RetryableError(fmt.Errorf("SQL Server instance %s is still terminating, status %d.... ", instanceId, status))
+	})
 }
 
 func (me *SqlserverService) DeleteSqlserverInstance(ctx context.Context, instanceId string) (errRet error) {
+	defer me.invalidateDescribeCache(instanceId)
 	logId := getLogId(ctx)
 	request := sqlserver.NewDeleteDBInstanceRequest()
 	request.InstanceId = &instanceId
@@ -313,11 +566,11 @@ func (me *SqlserverService) DeleteSqlserverInstance(ctx context.Context, instanc
 	}()
 
 	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().DeleteDBInstance(request)
+	_, err := me.sqlserverClient().DeleteDBInstance(request)
 	return err
 }
 
-func (me *SqlserverService) DescribeSqlserverInstances(ctx context.Context, instanceId string, projectId int, vpcId string, subnetId string, netType int) (instanceList []*sqlserver.DBInstance, errRet error) {
+func (me *SqlserverService) DescribeSqlserverInstances(ctx context.Context, instanceId string, instanceName string, projectId int, vpcId string, subnetId string, netType int, status int) (instanceList []*sqlserver.DBInstance, errRet error) {
 	logId := getLogId(ctx)
 	request := sqlserver.NewDescribeDBInstancesRequest()
 	defer func() {
@@ -344,32 +597,52 @@ func (me *SqlserverService) DescribeSqlserverInstances(ctx context.Context, inst
 		request.VpcId = helper.String("")
 		request.SubnetId = helper.String("")
 	}
+	if status > 0 {
+		request.Status = helper.IntInt64(status)
+	}
 	var offset, limit int64 = 0, 20
 
 	request.Offset = &offset
 	request.Limit = &limit
 
-	for {
+	errRet = paginate(ctx, limit, SQLSERVER_PAGINATION_MAX_PAGES, request.GetAction(), func(pageOffset int64) (int, error) {
+		offset = pageOffset
 		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+		response, err := me.sqlserverClient().DescribeDBInstances(request)
 		if err != nil {
-			errRet = err
-			return
+			return 0, err
 		}
 		if response == nil || response.Response == nil {
-			errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
-			return
+			return 0, fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 		}
 		instanceList = append(instanceList, response.Response.DBInstances...)
-		if len(response.Response.DBInstances) < int(limit) {
-			return
+		return len(response.Response.DBInstances), nil
+	})
+	if errRet != nil {
+		return
+	}
+
+	// the API has no fuzzy name search, filter locally after pagination
+	if instanceName != "" {
+		var matched []*sqlserver.DBInstance
+		for _, v := range instanceList {
+			if v.Name != nil && strings.Contains(*v.Name, instanceName) {
+				matched = append(matched, v)
+			}
 		}
-		offset += limit
+		instanceList = matched
 	}
+	return
 }
 
 func (me *SqlserverService) DescribeSqlserverInstanceById(ctx context.Context, instanceId string) (instance *sqlserver.DBInstance, has bool, errRet error) {
-	instanceList, err := me.DescribeSqlserverInstances(ctx, instanceId, -1, "", "", 1)
+	if cached, ok := me.instanceCache[instanceId]; ok {
+		instance = cached
+		has = !sqlserverInstanceHasDropped(*cached.Status)
+		return
+	}
+
+	instanceList, err := me.DescribeSqlserverInstances(ctx, instanceId, "", -1, "", "", 1, -1)
 	if err != nil {
 		errRet = err
 		return
@@ -381,12 +654,77 @@ func (me *SqlserverService) DescribeSqlserverInstanceById(ctx context.Context, i
 	}
 
 	instance = instanceList[0]
-	if instance != nil && *instance.Status != 8 && *instance.Status != 4 && *instance.Status != 6 {
+	if instance != nil && !sqlserverInstanceHasDropped(*instance.Status) {
+		has = true
+	}
+	if instance != nil {
+		if me.instanceCache == nil {
+			me.instanceCache = make(map[string]*sqlserver.DBInstance)
+		}
+		me.instanceCache[instanceId] = instance
+	}
+	return
+}
+
+// DescribeSqlserverInstanceStatus returns the raw status of the instance without hiding the
+// isolated/recycled/off-line states, so isolate and terminate flows can poll all the way to
+// their target status. The describe cache is bypassed since the method exists to observe
+// status changes.
+func (me *SqlserverService) DescribeSqlserverInstanceStatus(ctx context.Context, instanceId string) (status int, found bool, errRet error) {
+	me.invalidateDescribeCache(instanceId)
+	instance, _, err := me.DescribeSqlserverInstanceById(ctx, instanceId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if instance == nil || instance.Status == nil {
+		return
+	}
+	return int(*instance.Status), true, nil
+}
+
+// DescribeSqlserverInstanceByVip resolves an instance from its connection endpoint by paging the
+// whole region list and matching on Vip/Vport, since the list API cannot filter by address.
+// It is mainly useful when importing an instance whose ID is unknown.
+func (me *SqlserverService) DescribeSqlserverInstanceByVip(ctx context.Context, vip string, port int) (instance *sqlserver.DBInstance, has bool, errRet error) {
+	instanceList, err := me.DescribeSqlserverInstances(ctx, "", "", -1, "", "", 1, -1)
+	if err != nil {
+		errRet = err
+		return
+	}
+	for _, v := range instanceList {
+		if v.Vip == nil || v.Vport == nil {
+			continue
+		}
+		if *v.Vip == vip && int(*v.Vport) == port {
+			if instance != nil {
+				errRet = fmt.Errorf("more than one SQL Server instance listens on %s:%d", vip, port)
+				return
+			}
+			instance = v
+		}
+	}
+	if instance != nil && !sqlserverInstanceHasDropped(*instance.Status) {
 		has = true
 	}
 	return
 }
 
+// DescribeRunningSqlserverInstanceById works like DescribeSqlserverInstanceById but folds the
+// not-found and dropped states into errSqlserverInstanceNotFound.
+func (me *SqlserverService) DescribeRunningSqlserverInstanceById(ctx context.Context, instanceId string) (instance *sqlserver.DBInstance, errRet error) {
+	instance, has, err := me.DescribeSqlserverInstanceById(ctx, instanceId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if !has {
+		instance = nil
+		errRet = errSqlserverInstanceNotFound
+	}
+	return
+}
+
 func (me *SqlserverService) DescribeMaintenanceSpan(ctx context.Context, instanceId string) (weekSet []int, startTime string, timeSpan int, errRet error) {
 	logId := getLogId(ctx)
 	request := sqlserver.NewDescribeMaintenanceSpanRequest()
@@ -397,7 +735,7 @@ func (me *SqlserverService) DescribeMaintenanceSpan(ctx context.Context, instanc
 		}
 	}()
 	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeMaintenanceSpan(request)
+	response, err := me.sqlserverClient().DescribeMaintenanceSpan(request)
 	if err != nil {
 		errRet = err
 		return
@@ -426,7 +764,7 @@ func (me *SqlserverService) DescribeInstanceSecurityGroups(ctx context.Context,
 		}
 	}()
 	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeDBSecurityGroups(request)
+	response, err := me.sqlserverClient().DescribeDBSecurityGroups(request)
 	if err != nil {
 		errRet = err
 		return
@@ -443,7 +781,12 @@ func (me *SqlserverService) DescribeInstanceSecurityGroups(ctx context.Context,
 	return
 }
 
-func (me *SqlserverService) DescribeSqlserverBackups(ctx context.Context, instanceId string, startTime string, endTime string) (backupList []*sqlserver.Backup, errRet error) {
+// DescribeSqlserverBackups pages through the backup list. strategy filters on the backup
+// strategy (0 instance, 1 multi-databases) and backupWay on how the backup was triggered
+// (0 timed, 1 manual); pass -1 to skip either filter. When a page fails mid-iteration, the
+// pages fetched so far are still returned alongside the error and totalCount carries the
+// server-side total, so callers can degrade to partial results instead of dropping everything.
+func (me *SqlserverService) DescribeSqlserverBackups(ctx context.Context, instanceId string, startTime string, endTime string, strategy, backupWay int64) (backupList []*sqlserver.Backup, totalCount int64, errRet error) {
 	logId := getLogId(ctx)
 	request := sqlserver.NewDescribeBackupsRequest()
 	defer func() {
@@ -455,29 +798,35 @@ func (me *SqlserverService) DescribeSqlserverBackups(ctx context.Context, instan
 	request.InstanceId = &instanceId
 	request.StartTime = &startTime
 	request.EndTime = &endTime
+	if strategy >= 0 {
+		request.Strategy = &strategy
+	}
+	if backupWay >= 0 {
+		request.BackupWay = &backupWay
+	}
 
 	var offset, limit int64 = 0, 20
 
 	request.Offset = &offset
 	request.Limit = &limit
 
-	for {
+	errRet = paginate(ctx, limit, SQLSERVER_PAGINATION_MAX_PAGES, request.GetAction(), func(pageOffset int64) (int, error) {
+		offset = pageOffset
 		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseSqlserverClient().DescribeBackups(request)
+		response, err := me.sqlserverClient().DescribeBackups(request)
 		if err != nil {
-			errRet = err
-			return
+			return 0, err
 		}
 		if response == nil || response.Response == nil {
-			errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
-			return
+			return 0, fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 		}
 		backupList = append(backupList, response.Response.Backups...)
-		if len(response.Response.Backups) < int(limit) {
-			return
+		if response.Response.TotalCount != nil {
+			totalCount = *response.Response.TotalCount
 		}
-		offset += limit
-	}
+		return len(response.Response.Backups), nil
+	})
+	return
 }
 
 func (me *SqlserverService) DescribeReadonlyGroupList(ctx context.Context, instanceId string) (groupList []*sqlserver.ReadOnlyGroup, errRet error) {
@@ -490,7 +839,7 @@ func (me *SqlserverService) DescribeReadonlyGroupList(ctx context.Context, insta
 		}
 	}()
 	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupList(request)
+	response, err := me.sqlserverClient().DescribeReadOnlyGroupList(request)
 	if err != nil {
 		errRet = err
 		return
@@ -505,6 +854,47 @@ func (me *SqlserverService) DescribeReadonlyGroupList(ctx context.Context, insta
 	return
 }
 
+func (me *SqlserverService) ModifySqlserverReadonlyGroup(ctx context.Context, instanceId, readonlyGroupId, name string, isOfflineDelay int, maxDelayTime, minInGroup int, weightPairs []*sqlserver.ReadOnlyInstanceWeightPair) (errRet error) {
+	logId := getLogId(ctx)
+	request := sqlserver.NewModifyReadOnlyGroupDetailsRequest()
+	request.InstanceId = &instanceId
+	request.ReadOnlyGroupId = &readonlyGroupId
+	if name != "" {
+		request.ReadOnlyGroupName = &name
+	}
+	request.IsOfflineDelay = helper.IntInt64(isOfflineDelay)
+	if isOfflineDelay == 1 {
+		request.ReadOnlyMaxDelayTime = helper.IntInt64(maxDelayTime)
+		request.MinReadOnlyInGroup = helper.IntInt64(minInGroup)
+	}
+	if len(weightPairs) > 0 {
+		// manual weights, otherwise leave the weights to the system
+		request.WeightPairs = weightPairs
+		request.AutoWeight = helper.IntInt64(0)
+	} else {
+		request.AutoWeight = helper.IntInt64(1)
+	}
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
+		}
+	}()
+
+	err := retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, e := me.sqlserverClient().ModifyReadOnlyGroupDetails(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
+			return retryErrorWithAction(request.GetAction(), e)
+		}
+		return nil
+	})
+	if err != nil {
+		errRet = err
+	}
+	return
+}
+
 func (me *SqlserverService) CreateSqlserverReadonlyInstance(ctx context.Context, masterInstanceId string, subnetId string, vpcId string, chargeType string, memory int, zone string, storage int, readonlyGroupType int, readonlyGroupId string, forceUpgrade bool, securityGroups []string) (instanceId string, errRet error) {
 	logId := getLogId(ctx)
 	request := sqlserver.NewCreateReadOnlyDBInstancesRequest()
@@ -538,7 +928,7 @@ func (me *SqlserverService) CreateSqlserverReadonlyInstance(ctx context.Context,
 	}
 
 	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().CreateReadOnlyDBInstances(request)
+	response, err := me.sqlserverClient().CreateReadOnlyDBInstances(request)
 	if err != nil {
 		errRet = err
 		return
@@ -548,24 +938,34 @@ func (me *SqlserverService) CreateSqlserverReadonlyInstance(ctx context.Context,
 		return
 	}
 	if len(response.Response.DealNames) == 0 {
-		errRet = fmt.Errorf("TencentCloud SDK returns empty SQL Server ID")
-		return
-	} else if len(response.Response.DealNames) > 1 {
-		errRet = fmt.Errorf("TencentCloud SDK returns more than one SQL Server ID")
+		errRet = fmt.Errorf("TencentCloud SDK returns empty SQL Server ID, request id %s", helper.PString(response.Response.RequestId))
 		return
 	}
 
-	dealId := *response.Response.DealNames[0]
-
-	instanceId, err = me.GetInfoFromDeal(ctx, dealId)
+	dealNames := make([]string, 0, len(response.Response.DealNames))
+	for _, v := range response.Response.DealNames {
+		dealNames = append(dealNames, *v)
+	}
+	instanceIds, err := me.GetInfoFromDeals(ctx, dealNames)
 
 	if err != nil {
 		errRet = err
+		return
+	}
+	if len(instanceIds) == 0 {
+		errRet = fmt.Errorf("TencentCloud SDK returns empty SQL Server ID")
+		return
+	} else if len(instanceIds) > 1 {
+		errRet = fmt.Errorf("TencentCloud SDK returns more than one SQL Server ID")
+		return
 	}
+	instanceId = instanceIds[0]
+
+	errRet = me.WaitForSqlserverInstance(ctx, instanceId)
 	return
 }
 
-func (me *SqlserverService) DescribeReadonlyGroupListByReadonlyInstanceId(ctx context.Context, instanceId string) (readonlyGroupId string, masterInstanceId string, errRet error) {
+func (me *SqlserverService) DescribeReadonlyGroupListByReadonlyInstanceId(ctx context.Context, instanceId string) (readonlyGroupId string, masterInstanceId string, vip string, vport int64, errRet error) {
 	logId := getLogId(ctx)
 	request := sqlserver.NewDescribeReadOnlyGroupByReadOnlyInstanceRequest()
 	request.InstanceId = &instanceId
@@ -575,7 +975,7 @@ func (me *SqlserverService) DescribeReadonlyGroupListByReadonlyInstanceId(ctx co
 		}
 	}()
 	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeReadOnlyGroupByReadOnlyInstance(request)
+	response, err := me.sqlserverClient().DescribeReadOnlyGroupByReadOnlyInstance(request)
 	if err != nil {
 		errRet = err
 		return
@@ -587,11 +987,20 @@ func (me *SqlserverService) DescribeReadonlyGroupListByReadonlyInstanceId(ctx co
 
 	readonlyGroupId = *response.Response.ReadOnlyGroupId
 	masterInstanceId = *response.Response.MasterInstanceId
+	if response.Response.Vip != nil {
+		vip = *response.Response.Vip
+	}
+	if response.Response.Vport != nil {
+		vport = *response.Response.Vport
+	}
 	return
 }
 
 func (me *SqlserverService) CreateSqlserverAccount(ctx context.Context, instanceId string, userName string, password string, remark string, isAdmin bool) (errRet error) {
 	logId := getLogId(ctx)
+	if errRet = validatePassword(password, "sqlserver"); errRet != nil {
+		return
+	}
 	request := sqlserver.NewCreateAccountRequest()
 	defer func() {
 		if errRet != nil {
@@ -603,8 +1012,17 @@ func (me *SqlserverService) CreateSqlserverAccount(ctx context.Context, instance
 	account := sqlserver.AccountCreateInfo{UserName: &userName, Password: &password, IsAdmin: &isAdmin, Remark: &remark}
 	request.Accounts = []*sqlserver.AccountCreateInfo{&account}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().CreateAccount(request)
+	var response *sqlserver.CreateAccountResponse
+	err := retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		result, e := me.sqlserverClient().CreateAccount(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
+			return sqlserverRetryError(request.GetAction(), e)
+		}
+		response = result
+		return nil
+	})
 	if err != nil {
 		errRet = err
 		return
@@ -618,7 +1036,10 @@ func (me *SqlserverService) CreateSqlserverAccount(ctx context.Context, instance
 	err = me.WaitForTaskFinish(ctx, flowId)
 	if err != nil {
 		errRet = err
+		return
 	}
+
+	errRet = me.WaitForSqlserverAccountStatus(ctx, instanceId, userName, false)
 	return
 }
 
@@ -638,29 +1059,37 @@ func (me *SqlserverService) DescribeSqlserverAccounts(ctx context.Context, insta
 	request.Offset = &offset
 	request.Limit = &limit
 
-	for {
+	errRet = paginate(ctx, int64(limit), SQLSERVER_PAGINATION_MAX_PAGES, request.GetAction(), func(pageOffset int64) (int, error) {
+		offset = uint64(pageOffset)
 		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseSqlserverClient().DescribeAccounts(request)
+		response, err := me.sqlserverClient().DescribeAccounts(request)
 		if err != nil {
-			ee, ok := err.(*SDKErrors.TencentCloudSDKError)
-			if !ok || ee.Code != "ResourceNotFound.InstanceNotFound" {
-				errRet = err
-				return
+			if isNotFoundError(err) {
+				// a vanished instance simply has no accounts
+				return 0, nil
 			}
-
-			return
-
+			return 0, err
 		}
 		if response == nil || response.Response == nil {
-			errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
-			return
+			return 0, fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 		}
 		accounts = append(accounts, response.Response.Accounts...)
-		if len(response.Response.Accounts) < int(limit) {
-			return
+		return len(response.Response.Accounts), nil
+	})
+	return
+}
+
+// findSqlserverAccount matches an account by name. The SDK names the field UserName on all
+// write models (CreateAccountRequest, AccountRemark, AccountPrivilegeModifyInfo...) but Name
+// on AccountDetail, so lookups are centralized here instead of matching the fields ad hoc;
+// accounts without a name never match.
+func findSqlserverAccount(accounts []*sqlserver.AccountDetail, userName string) (*sqlserver.AccountDetail, bool) {
+	for _, account := range accounts {
+		if account != nil && account.Name != nil && *account.Name == userName {
+			return account, true
 		}
-		offset += limit
 	}
+	return nil, false
 }
 
 func (me *SqlserverService) DescribeSqlserverAccountById(ctx context.Context, instanceId string, userName string) (account *sqlserver.AccountDetail, has bool, errRet error) {
@@ -669,17 +1098,38 @@ func (me *SqlserverService) DescribeSqlserverAccountById(ctx context.Context, in
 		errRet = err
 		return
 	}
-	if len(accountList) == 0 {
-		return
-	}
 
-	for _, v := range accountList {
-		if *v.Name == userName {
-			account = v
-			has = true
-			return
+	account, has = findSqlserverAccount(accountList, userName)
+	return
+}
+
+// WaitForSqlserverAccountStatus polls the account until it settles. With targetAbsent the wait
+// succeeds once the account is gone; otherwise it succeeds when the account leaves the
+// creating/modifying/resetting states.
+func (me *SqlserverService) WaitForSqlserverAccountStatus(ctx context.Context, instanceId, userName string, targetAbsent bool) (errRet error) {
+	errRet = retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		account, has, err := me.DescribeSqlserverAccountById(ctx, instanceId, userName)
+		if err != nil {
+			return resource.NonRetryableError(errors.WithStack(err))
 		}
-	}
+		if targetAbsent {
+			if !has {
+				return nil
+			}
+			if int(*account.Status) == SQLSERVER_ACCOUNT_DELETING {
+				return resource.RetryableError(fmt.Errorf("deleting , SQL Server instance ID %s, name %s, status %d.... ", instanceId, userName, *account.Status))
+			}
+			return resource.NonRetryableError(fmt.Errorf("invalid, SQL Server instance ID %s, name %s, status %d...", instanceId, userName, *account.Status))
+		}
+		if !has {
+			return resource.NonRetryableError(fmt.Errorf("cannot find SQL Server account %s%s%s", instanceId, FILED_SP, userName))
+		}
+		switch int(*account.Status) {
+		case SQLSERVER_ACCOUNT_CREATING, SQLSERVER_ACCOUNT_MODIFYING, SQLSERVER_ACCOUNT_RESETTING:
+			return resource.RetryableError(fmt.Errorf("settling , SQL Server instance ID %s, name %s, status %d.... ", instanceId, userName, *account.Status))
+		}
+		return nil
+	})
 	return
 }
 
@@ -694,13 +1144,22 @@ func (me *SqlserverService) ModifySqlserverAccountRemark(ctx context.Context, in
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().ModifyAccountRemark(request)
-	return err
+	return retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, e := me.sqlserverClient().ModifyAccountRemark(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
+			return sqlserverRetryError(request.GetAction(), e)
+		}
+		return nil
+	})
 }
 
 func (me *SqlserverService) ResetSqlserverAccountPassword(ctx context.Context, instanceId string, userName string, password string) (errRet error) {
 	logId := getLogId(ctx)
+	if errRet = validatePassword(password, "sqlserver"); errRet != nil {
+		return
+	}
 	request := sqlserver.NewResetAccountPasswordRequest()
 	request.InstanceId = &instanceId
 	request.Accounts = []*sqlserver.AccountPassword{{UserName: &userName, Password: &password}}
@@ -710,29 +1169,22 @@ func (me *SqlserverService) ResetSqlserverAccountPassword(ctx context.Context, i
 		}
 	}()
 
-	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().ResetAccountPassword(request)
+	err := retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		_, e := me.sqlserverClient().ResetAccountPassword(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
+			return sqlserverRetryError(request.GetAction(), e)
+		}
+		return nil
+	})
 	if err != nil {
 		errRet = err
 		return
 	}
 
 	//check status not resetting
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		instance, has, err := me.DescribeSqlserverAccountById(ctx, instanceId, userName)
-		if err != nil {
-			return resource.NonRetryableError(errors.WithStack(err))
-		}
-		if !has {
-			return resource.NonRetryableError(fmt.Errorf("cannot find SQL Server account %s%s%s", instanceId, FILED_SP, userName))
-		}
-		if int(*instance.Status) == 4 {
-			return resource.RetryableError(fmt.Errorf("resetting , SQL Server instance ID %s, name %s, status %d.... ", instanceId, userName, *instance.Status))
-		} else {
-			return nil
-		}
-	})
-
+	errRet = me.WaitForSqlserverAccountStatus(ctx, instanceId, userName, false)
 	return
 }
 
@@ -748,40 +1200,47 @@ func (me *SqlserverService) DeleteSqlserverAccount(ctx context.Context, instance
 	}()
 
 	ratelimit.Check(request.GetAction())
-	_, err := me.client.UseSqlserverClient().DeleteAccount(request)
+	_, err := me.sqlserverClient().DeleteAccount(request)
 	if err != nil {
-		ee, ok := err.(*SDKErrors.TencentCloudSDKError)
-		if !ok || ee.Code != "ResourceNotFound.InstanceNotFound" {
+		if !isNotFoundError(err) {
 			errRet = err
-			return
 		}
 		return
 	}
 
 	//check status not deleting
-	errRet = resource.Retry(readRetryTimeout, func() *resource.RetryError {
-		instance, has, err := me.DescribeSqlserverAccountById(ctx, instanceId, userName)
-		if err != nil {
-			return resource.NonRetryableError(errors.WithStack(err))
-		}
-		if !has {
-			return nil
-		}
-		if int(*instance.Status) == -1 {
-			return resource.RetryableError(fmt.Errorf("deleting , SQL Server instance ID %s, name %s, status %d.... ", instanceId, userName, *instance.Status))
-		} else {
-			return resource.NonRetryableError(fmt.Errorf("invalid, SQL Server instance ID %s, name %s, status %d...", instanceId, userName, *instance.Status))
-		}
-	})
-
+	errRet = me.WaitForSqlserverAccountStatus(ctx, instanceId, userName, true)
 	return
 }
 
 func (me *SqlserverService) ModifyAccountDBAttachment(ctx context.Context, instanceId, accountName, dbName, privilege string) (errRet error) {
+	defer me.invalidateDescribeCache(instanceId)
+	//check db exists
+	_, has, err := me.DescribeDBDetailsById(ctx, fmt.Sprintf("%s%s%s", instanceId, FILED_SP, dbName))
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		return fmt.Errorf("SQL Server DB %s , instance ID %s is not exist", dbName, instanceId)
+	}
+
+	return me.ModifySqlserverAccountPrivileges(ctx, instanceId, accountName, map[string]string{dbName: privilege})
+}
+
+// ModifySqlserverAccountPrivileges changes the privileges of one account on several databases in a
+// single ModifyAccountPrivilege call, waiting on one flow instead of one flow per database.
+func (me *SqlserverService) ModifySqlserverAccountPrivileges(ctx context.Context, instanceId, accountName string, dbPrivileges map[string]string) (errRet error) {
+	defer me.invalidateDescribeCache(instanceId)
 	logId := getLogId(ctx)
 	request := sqlserver.NewModifyAccountPrivilegeRequest()
 	request.InstanceId = &instanceId
-	request.Accounts = []*sqlserver.AccountPrivilegeModifyInfo{{UserName: &accountName, DBPrivileges: []*sqlserver.DBPrivilegeModifyInfo{{DBName: &dbName, Privilege: &privilege}}}}
+	modifyInfo := sqlserver.AccountPrivilegeModifyInfo{UserName: &accountName}
+	for dbName, privilege := range dbPrivileges {
+		dbName, privilege := dbName, privilege
+		modifyInfo.DBPrivileges = append(modifyInfo.DBPrivileges, &sqlserver.DBPrivilegeModifyInfo{DBName: &dbName, Privilege: &privilege})
+	}
+	request.Accounts = []*sqlserver.AccountPrivilegeModifyInfo{&modifyInfo}
 
 	defer func() {
 		if errRet != nil {
@@ -800,81 +1259,87 @@ func (me *SqlserverService) ModifyAccountDBAttachment(ctx context.Context, insta
 		return fmt.Errorf("SQL Server account %s , instance ID %s is not exist", accountName, instanceId)
 	}
 
-	//check db exists
-	_, has, err = me.DescribeDBDetailsById(ctx, fmt.Sprintf("%s%s%s", instanceId, FILED_SP, dbName))
+	var response *sqlserver.ModifyAccountPrivilegeResponse
+	err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		result, e := me.sqlserverClient().ModifyAccountPrivilege(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
+			return sqlserverRetryError(request.GetAction(), e)
+		}
+		response = result
+		return nil
+	})
 	if err != nil {
 		return err
 	}
-
-	if !has {
-		return fmt.Errorf("SQL Server DB %s , instance ID %s is not exist", dbName, instanceId)
-	}
-
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().ModifyAccountPrivilege(request)
 	if response == nil || response.Response == nil {
 		errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 		return
 	}
 
-	if err != nil {
-		return err
-	}
-
 	flowId := int64(*response.Response.FlowId)
 	err = me.WaitForTaskFinish(ctx, flowId)
 	return err
 }
 
-func (me *SqlserverService) DescribeAccountDBAttachments(ctx context.Context, instanceId, accountName, dbName string) (attachments []map[string]string, errRet error) {
+// SqlserverAccountDBAttachment describes the privilege one account holds on one database.
+type SqlserverAccountDBAttachment struct {
+	AccountName string
+	DBName      string
+	Privilege   string
+}
 
-	if accountName != "" {
-		//check account exists
-		accounts, has, err := me.DescribeSqlserverAccountById(ctx, instanceId, accountName)
-		if err != nil {
-			errRet = err
-			return
-		}
-		if !has {
-			return
-		}
+func (me *SqlserverService) DescribeAccountDBAttachments(ctx context.Context, instanceId, accountName, dbName string) (attachments []SqlserverAccountDBAttachment, errRet error) {
+	logId := getLogId(ctx)
 
-		for _, v := range accounts.Dbs {
+	accounts, err := me.DescribeSqlserverAccounts(ctx, instanceId)
+	if err != nil {
+		errRet = err
+		return
+	}
 
-			if (dbName != "" && *v.DBName == dbName) || dbName == "" {
-				mapping := make(map[string]string)
-				mapping["db_name"] = *v.DBName
-				mapping["account_name"] = accountName
-				mapping["privilege"] = *v.Privilege
-				attachments = append(attachments, mapping)
+	for _, account := range accounts {
+		if accountName != "" && *account.Name != accountName {
+			continue
+		}
+		for _, v := range account.Dbs {
+			if dbName != "" && *v.DBName != dbName {
+				continue
 			}
+			attachments = append(attachments, SqlserverAccountDBAttachment{
+				AccountName: *account.Name,
+				DBName:      *v.DBName,
+				Privilege:   *v.Privilege,
+			})
 		}
+	}
+	if len(attachments) == 0 {
 		return
-	} else {
-		dbInfos, err := me.DescribeDBsOfInstance(ctx, instanceId)
-		if err != nil {
-			errRet = err
-			return
-		}
-		if len(dbInfos) == 0 {
-			return
+	}
+
+	//the account-side view is the source of truth, the DB-side view is only cross-checked
+	dbInfos, err := me.DescribeDBsOfInstance(ctx, instanceId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	dbSide := make(map[string]string)
+	for _, v := range dbInfos {
+		for _, vv := range v.Accounts {
+			dbSide[*vv.UserName+FILED_SP+*v.Name] = *vv.Privilege
 		}
-		for _, v := range dbInfos {
-			if (dbName != "" && *v.Name == dbName) || dbName == "" {
-				for _, vv := range v.Accounts {
-					mapping := make(map[string]string)
-					mapping["db_name"] = *v.Name
-					mapping["account_name"] = *vv.UserName
-					mapping["privilege"] = *vv.Privilege
-					attachments = append(attachments, mapping)
-				}
-			}
+	}
+	for _, attachment := range attachments {
+		if privilege := dbSide[attachment.AccountName+FILED_SP+attachment.DBName]; privilege != attachment.Privilege {
+			log.Printf("[WARN]%s SQL Server instance %s account %s privilege %s on DB %s disagrees with DB-side view %s",
+				logId, instanceId, attachment.AccountName, attachment.Privilege, attachment.DBName, privilege)
 		}
-		return
 	}
+	return
 }
 
-func (me *SqlserverService) DescribeAccountDBAttachmentById(ctx context.Context, instanceId, accountName, dbName string) (attachment map[string]string, has bool, errRet error) {
+func (me *SqlserverService) DescribeAccountDBAttachmentById(ctx context.Context, instanceId, accountName, dbName string) (attachment SqlserverAccountDBAttachment, has bool, errRet error) {
 	attachments, err := me.DescribeAccountDBAttachments(ctx, instanceId, accountName, dbName)
 	if err != nil {
 		errRet = err
@@ -891,9 +1356,28 @@ func (me *SqlserverService) DescribeAccountDBAttachmentById(ctx context.Context,
 }
 
 func (me *SqlserverService) GetInfoFromDeal(ctx context.Context, dealId string) (instanceId string, errRet error) {
+	instanceIds, err := me.GetInfoFromDeals(ctx, []string{dealId})
+	if err != nil {
+		errRet = err
+		return
+	}
+	if len(instanceIds) == 0 {
+		errRet = fmt.Errorf("TencentCloud SDK returns empty instance for deal %s", dealId)
+		return
+	} else if len(instanceIds) > 1 {
+		errRet = fmt.Errorf("TencentCloud SDK returns more than one instance for deal %s", dealId)
+		return
+	}
+	instanceId = instanceIds[0]
+	return
+}
+
+// GetInfoFromDeals resolves a batch of deal names with a single DescribeOrders call and waits on
+// each deal's flow, returning the instance IDs the deals produced.
+func (me *SqlserverService) GetInfoFromDeals(ctx context.Context, dealIds []string) (instanceIds []string, errRet error) {
 	logId := getLogId(ctx)
 	request := sqlserver.NewDescribeOrdersRequest()
-	request.DealNames = []*string{&dealId}
+	request.DealNames = helper.Strings(dealIds)
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
@@ -901,7 +1385,7 @@ func (me *SqlserverService) GetInfoFromDeal(ctx context.Context, dealId string)
 	}()
 
 	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseSqlserverClient().DescribeOrders(request)
+	response, err := me.sqlserverClient().DescribeOrders(request)
 	if err != nil {
 		errRet = err
 		return
@@ -911,17 +1395,63 @@ func (me *SqlserverService) GetInfoFromDeal(ctx context.Context, dealId string)
 		return
 	}
 	if len(response.Response.Deals) == 0 {
-		errRet = fmt.Errorf("TencentCloud SDK returns empty deal")
-		return
-	} else if len(response.Response.Deals) > 1 {
-		errRet = fmt.Errorf("TencentCloud SDK returns more than one deal")
+		errRet = fmt.Errorf("TencentCloud SDK returns empty deal, request id %s", helper.PString(response.Response.RequestId))
 		return
 	}
-	instanceId = *response.Response.Deals[0].InstanceIdSet[0]
-	flowId := *response.Response.Deals[0].FlowId
-	err = me.WaitForTaskFinish(ctx, flowId)
-	if err != nil {
-		errRet = err
+	for _, deal := range response.Response.Deals {
+		if deal.FlowId == nil {
+			continue
+		}
+		if err := me.WaitForTaskFinish(ctx, *deal.FlowId); err != nil {
+			errRet = err
+			return
+		}
+	}
+
+	instanceIds, complete := instanceIdsFromDeals(response.Response.Deals)
+	if complete {
+		return instanceIds, nil
+	}
+
+	// right after a create the order may still be processing, leaving InstanceIdSet
+	// empty for a short while even though the flow already reports success; poll
+	// DescribeOrders until every deal has produced its instances
+	errRet = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		result, e := me.sqlserverClient().DescribeOrders(request)
+		if e != nil {
+			return retryErrorWithAction(request.GetAction(), e)
+		}
+		if result == nil || result.Response == nil || len(result.Response.Deals) == 0 {
+			return resource.RetryableError(fmt.Errorf("deals %v are not listed yet", dealIds))
+		}
+		ids, complete := instanceIdsFromDeals(result.Response.Deals)
+		if !complete {
+			return resource.RetryableError(fmt.Errorf("deals %v have not produced all instances yet", dealIds))
+		}
+		instanceIds = ids
+		return nil
+	})
+	if errRet != nil {
+		instanceIds = nil
+	}
+	return
+}
+
+// instanceIdsFromDeals collects the instance IDs the deals produced. A deal
+// whose order is still processing carries an empty InstanceIdSet, in which case
+// complete is false so callers can poll DescribeOrders again instead of
+// indexing into the empty set.
+func instanceIdsFromDeals(deals []*sqlserver.DealInfo) (instanceIds []string, complete bool) {
+	complete = true
+	for _, deal := range deals {
+		if len(deal.InstanceIdSet) == 0 {
+			complete = false
+			continue
+		}
+		for _, id := range deal.InstanceIdSet {
+			instanceIds = append(instanceIds, *id)
+		}
 	}
 	return
 }
@@ -934,28 +1464,104 @@ func (me *SqlserverService) WaitForTaskFinish(ctx context.Context, flowId int64)
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), redactRequestBody(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
-	errRet = resource.Retry(4*writeRetryTimeout, func() *resource.RetryError {
-		taskResponse, err := me.client.UseSqlserverClient().DescribeFlowStatus(request)
-		log.Printf("This is the time out interface, thanks2")
-		ratelimit.Check(request.GetAction())
-		if err != nil {
-			return resource.NonRetryableError(errors.WithStack(err))
+	conf := &resource.StateChangeConf{
+		Pending: []string{strconv.Itoa(SQLSERVER_TASK_RUNNING)},
+		Target:  []string{strconv.Itoa(SQLSERVER_TASK_SUCCESS)},
+		Refresh: func() (interface{}, string, error) {
+			ratelimit.Check(request.GetAction())
+			taskResponse, err := me.sqlserverClient().DescribeFlowStatus(request)
+			if err != nil {
+				return nil, "", errors.WithStack(err)
+			}
+			if taskResponse == nil || taskResponse.Response == nil || taskResponse.Response.Status == nil {
+				// a transient nil response keeps the wait in the running state instead of panicking
+				return request, strconv.Itoa(SQLSERVER_TASK_RUNNING), nil
+			}
+			status := *taskResponse.Response.Status
+			if status == int64(SQLSERVER_TASK_FAIL) {
+				// DescribeFlowStatus carries no failure reason in the SDK in use,
+				// so name the flow and point at the operation log instead
+				return nil, "", errors.WithStack(fmt.Errorf(
+					"SQL Server flow %d failed, requestId is %s, check the instance operation log in the console for the failure reason",
+					flowId, helper.PString(taskResponse.Response.RequestId)))
+			}
+			return taskResponse, strconv.FormatInt(status, 10), nil
+		},
+		Timeout:      4 * writeRetryTimeout,
+		PollInterval: sqlserverTaskPollInterval(),
+		MinTimeout:   time.Second,
+	}
+	_, errRet = conf.WaitForState()
+	return
+}
+
+// sqlserverTaskPollInterval controls how often WaitForTaskFinish polls DescribeFlowStatus while a
+// deal or flow runs. Large accounts can raise it through the TENCENTCLOUD_POLL_INTERVAL
+// environment variable, a duration string such as "30s", so long waits do not burn the
+// rate-limit budget.
+func sqlserverTaskPollInterval() time.Duration {
+	return resolveRetryTimeout("TENCENTCLOUD_POLL_INTERVAL", 5*time.Second)
+}
+
+// DescribeSqlserverInstanceMonitor pulls monitor values of the given metric (e.g. connections,
+// used_storage) for one instance from the cloud monitor, time format like 2020-06-01T12:00:00+08:00.
+func (me *SqlserverService) DescribeSqlserverInstanceMonitor(ctx context.Context, instanceId, metric string, startTime, endTime string) (values []float64, errRet error) {
+	logId := getLogId(ctx)
+	request := monitor.NewGetMonitorDataRequest()
+	request.Namespace = helper.String(SQLSERVER_MONITOR_NAMESPACE)
+	request.MetricName = &metric
+	request.StartTime = &startTime
+	request.EndTime = &endTime
+	request.Instances = []*monitor.Instance{{
+		Dimensions: []*monitor.Dimension{{
+			Name:  helper.String("resourceId"),
+			Value: &instanceId,
+		}},
+	}}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), redactRequestBody(request.ToJsonString()), errRet.Error())
 		}
-		if *taskResponse.Response.Status == int64(SQLSERVER_TASK_RUNNING) {
-			return resource.RetryableError(errors.WithStack(fmt.Errorf("SQLSERVER task status is %d(task running), requestId is %s", *taskResponse.Response.Status, *taskResponse.Response.RequestId)))
-		} else if *taskResponse.Response.Status == int64(SQLSERVER_TASK_FAIL) {
-			return resource.NonRetryableError(errors.WithStack(fmt.Errorf("SQLSERVER task status is %d(failed), requestId is %s", *taskResponse.Response.Status, *taskResponse.Response.RequestId)))
+	}()
+
+	var response *monitor.GetMonitorDataResponse
+	err := retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		result, e := me.client.UseMonitorClient().GetMonitorData(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
+			return retryErrorWithAction(request.GetAction(), e)
 		}
+		response = result
 		return nil
 	})
+	if err != nil {
+		errRet = err
+		return
+	}
+	if response == nil || response.Response == nil {
+		errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
+		return
+	}
+
+	for _, dataPoint := range response.Response.DataPoints {
+		for _, v := range dataPoint.Values {
+			if v != nil {
+				values = append(values, *v)
+			}
+		}
+	}
 	return
 }
 
 func (me *SqlserverService) CreateSqlserverDB(ctx context.Context, instanceID string, dbname string, charset string, remark string) (errRet error) {
+	defer me.invalidateDescribeCache(instanceID)
 	logId := getLogId(ctx)
 	request := sqlserver.NewCreateDBRequest()
 
@@ -974,17 +1580,17 @@ func (me *SqlserverService) CreateSqlserverDB(ctx context.Context, instanceID st
 	defer func() {
 		if errRet != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), redactRequestBody(request.ToJsonString()), errRet.Error())
 		}
 	}()
 
 	var response *sqlserver.CreateDBResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseSqlserverClient().CreateDB(request)
+		result, e := me.sqlserverClient().CreateDB(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
-			return retryError(e)
+			return retryErrorWithAction(request.GetAction(), e)
 		}
 		response = result
 		return nil
@@ -1001,6 +1607,11 @@ func (me *SqlserverService) CreateSqlserverDB(ctx context.Context, instanceID st
 }
 
 func (me *SqlserverService) DescribeDBsOfInstance(ctx context.Context, instanceId string) (instanceDBList []*sqlserver.DBDetail, errRet error) {
+	if cached, ok := me.dbCache[instanceId]; ok {
+		instanceDBList = cached
+		return
+	}
+
 	logId := getLogId(ctx)
 	request := sqlserver.NewDescribeDBsRequest()
 	defer func() {
@@ -1017,38 +1628,58 @@ func (me *SqlserverService) DescribeDBsOfInstance(ctx context.Context, instanceI
 	request.Offset = &offset
 	request.Limit = &limit
 
-	for {
+	errRet = paginate(ctx, int64(limit), SQLSERVER_PAGINATION_MAX_PAGES, request.GetAction(), func(pageOffset int64) (int, error) {
+		offset = uint64(pageOffset)
 		var response *sqlserver.DescribeDBsResponse
-		err := resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		err := retryWithContext(ctx, readRetryTimeout, func() *resource.RetryError {
 			ratelimit.Check(request.GetAction())
-			result, e := me.client.UseSqlserverClient().DescribeDBs(request)
+			result, e := me.sqlserverClient().DescribeDBs(request)
 			if e != nil {
 				log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
-				return retryError(e)
+				return retryErrorWithAction(request.GetAction(), e)
 			}
 			response = result
 			return nil
 		})
 		if err != nil {
-			errRet = err
-			return
+			return 0, err
 		}
 		if response == nil || response.Response == nil {
-			errRet = fmt.Errorf("TencentCloud SDK returns nil response for api[%s]", request.GetAction())
-			return
+			return 0, fmt.Errorf("TencentCloud SDK returns nil response for api[%s]", request.GetAction())
 		}
 		if len(response.Response.DBInstances) == 0 {
-			return
+			return 0, nil
 		} else if len(response.Response.DBInstances) > 1 {
-			errRet = fmt.Errorf("[CRITAL]%s api[%s] returned multiple DB lists for one instance", logId, request.GetAction())
-			return
+			return 0, fmt.Errorf("[CRITAL]%s api[%s] returned multiple DB lists for one instance", logId, request.GetAction())
 		}
+		// offset/limit page through the databases of the single instance, so the
+		// page size is the number of databases returned, not the instance count
 		instanceDBList = append(instanceDBList, response.Response.DBInstances[0].DBDetails...)
-		if len(response.Response.DBInstances) < int(limit) {
-			return
+		return len(response.Response.DBInstances[0].DBDetails), nil
+	})
+	if errRet == nil {
+		if me.dbCache == nil {
+			me.dbCache = make(map[string][]*sqlserver.DBDetail)
+		}
+		me.dbCache[instanceId] = instanceDBList
+	}
+	return
+}
+
+// DescribeSqlserverDBsByStatus lists only the databases of an instance that sit in the given
+// status, so callers can skip databases that are mid-deletion.
+func (me *SqlserverService) DescribeSqlserverDBsByStatus(ctx context.Context, instanceId string, status int64) (instanceDBList []*sqlserver.DBDetail, errRet error) {
+	dbInfos, err := me.DescribeDBsOfInstance(ctx, instanceId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	for _, dbDetail := range dbInfos {
+		if *dbDetail.Status == status {
+			instanceDBList = append(instanceDBList, dbDetail)
 		}
-		offset += limit
 	}
+	return
 }
 
 func (me *SqlserverService) DescribeDBDetailsById(ctx context.Context, dbId string) (dbInfo *sqlserver.DBDetail, has bool, errRet error) {
@@ -1082,6 +1713,7 @@ func (me *SqlserverService) DescribeDBDetailsById(ctx context.Context, dbId stri
 }
 
 func (me *SqlserverService) ModifySqlserverDBRemark(ctx context.Context, instanceId string, dbName string, remark string) (errRet error) {
+	defer me.invalidateDescribeCache(instanceId)
 	logId := getLogId(ctx)
 	request := sqlserver.NewModifyDBRemarkRequest()
 	request.InstanceId = &instanceId
@@ -1092,12 +1724,12 @@ func (me *SqlserverService) ModifySqlserverDBRemark(ctx context.Context, instanc
 		}
 	}()
 
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		ratelimit.Check(request.GetAction())
-		_, e := me.client.UseSqlserverClient().ModifyDBRemark(request)
+		_, e := me.sqlserverClient().ModifyDBRemark(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
-			return retryError(e)
+			return retryErrorWithAction(request.GetAction(), e)
 		}
 		return nil
 	})
@@ -1108,7 +1740,57 @@ func (me *SqlserverService) ModifySqlserverDBRemark(ctx context.Context, instanc
 	return
 }
 
-func (me *SqlserverService) DeleteSqlserverDB(ctx context.Context, instanceId string, name string) (errRet error) {
+func (me *SqlserverService) RenameSqlserverDB(ctx context.Context, instanceId, oldName, newName string) (errRet error) {
+	defer me.invalidateDescribeCache(instanceId)
+	logId := getLogId(ctx)
+	request := sqlserver.NewModifyDBNameRequest()
+	request.InstanceId = &instanceId
+	request.OldDBName = &oldName
+	request.NewDBName = &newName
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason[%s]", logId, request.GetAction(), errRet.Error())
+		}
+	}()
+
+	// reject renaming onto an existing database up front, the flow error of the API is hard to read
+	_, has, err := me.DescribeDBDetailsById(ctx, instanceId+FILED_SP+newName)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if has {
+		errRet = fmt.Errorf("SQL Server DB %s already exists on instance %s, rename from %s is rejected", newName, instanceId, oldName)
+		return
+	}
+
+	var response *sqlserver.ModifyDBNameResponse
+	err = retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
+		ratelimit.Check(request.GetAction())
+		result, e := me.sqlserverClient().ModifyDBName(request)
+		if e != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, reason:%s\n", logId, request.GetAction(), e.Error())
+			return retryErrorWithAction(request.GetAction(), e)
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	if response != nil && response.Response != nil && *response.Response.FlowId != 0 {
+		return me.WaitForTaskFinish(ctx, *response.Response.FlowId)
+	}
+	return
+}
+
+// DeleteSqlserverDB drops one database. A database with active connections is rejected by
+// the API; with force set that conflict is retried with backoff until the connections drain
+// (the SDK offers no kill-session action), otherwise it fails fast with a clear message.
+func (me *SqlserverService) DeleteSqlserverDB(ctx context.Context, instanceId string, name string, force bool) (errRet error) {
+	defer me.invalidateDescribeCache(instanceId)
 	logId := getLogId(ctx)
 	request := sqlserver.NewDeleteDBRequest()
 	request.InstanceId = &instanceId
@@ -1120,12 +1802,19 @@ func (me *SqlserverService) DeleteSqlserverDB(ctx context.Context, instanceId st
 	}()
 
 	var response *sqlserver.DeleteDBResponse
-	err := resource.Retry(writeRetryTimeout, func() *resource.RetryError {
+	err := retryWithContext(ctx, writeRetryTimeout, func() *resource.RetryError {
 		ratelimit.Check(request.GetAction())
-		result, e := me.client.UseSqlserverClient().DeleteDB(request)
+		result, e := me.sqlserverClient().DeleteDB(request)
 		if e != nil {
 			log.Printf("[CRITAL]%s %s fail, reason:%s\n", logId, request.GetAction(), e.Error())
-			return retryError(e)
+			if isSdkErrorCode(e, "ResourceInUse", "OperationDenied") {
+				if force {
+					recordRetry(request.GetAction())
+					return resource.RetryableError(fmt.Errorf("database %s of instance %s is in use, waiting for connections to drain: %s", name, instanceId, e.Error()))
+				}
+				return resource.NonRetryableError(fmt.Errorf("database %s of instance %s is in use, close the active connections or set force_delete to wait for them: %s", name, instanceId, e.Error()))
+			}
+			return retryErrorWithAction(request.GetAction(), e)
 		}
 		response = result
 		return nil
@@ -0,0 +1,62 @@
+package tencentcloud
+
+import (
+	"reflect"
+	"testing"
+
+	sqlserver "github.com/liucy1983/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
+	"github.com/terraform-providers/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+// TestFindSqlserverAccount confirms an account created through the UserName request field
+// is found again by the describe-side lookup, which matches on AccountDetail.Name.
+func TestFindSqlserverAccount(t *testing.T) {
+	createRequest := sqlserver.NewCreateAccountRequest()
+	createRequest.Accounts = []*sqlserver.AccountCreateInfo{{UserName: helper.String("tf_sqlserver_account")}}
+
+	accounts := []*sqlserver.AccountDetail{
+		nil,
+		{Remark: helper.String("account without a name")},
+		{Name: helper.String("other_account")},
+		{Name: createRequest.Accounts[0].UserName},
+	}
+
+	account, has := findSqlserverAccount(accounts, "tf_sqlserver_account")
+	if !has || account == nil || *account.Name != "tf_sqlserver_account" {
+		t.Errorf("findSqlserverAccount did not find the created account, got (%v, %v)", account, has)
+	}
+
+	if account, has := findSqlserverAccount(accounts, "missing_account"); has || account != nil {
+		t.Errorf("findSqlserverAccount matched a missing account: %v", account)
+	}
+}
+
+func TestInstanceIdsFromDeals(t *testing.T) {
+	deal := func(ids ...string) *sqlserver.DealInfo {
+		d := &sqlserver.DealInfo{InstanceIdSet: make([]*string, 0, len(ids))}
+		for _, id := range ids {
+			d.InstanceIdSet = append(d.InstanceIdSet, helper.String(id))
+		}
+		return d
+	}
+
+	tests := []struct {
+		deals       []*sqlserver.DealInfo
+		instanceIds []string
+		complete    bool
+	}{
+		{nil, nil, true},
+		{[]*sqlserver.DealInfo{deal("mssql-1")}, []string{"mssql-1"}, true},
+		{[]*sqlserver.DealInfo{deal("mssql-1", "mssql-2")}, []string{"mssql-1", "mssql-2"}, true},
+		// a deal whose order is still processing has no instances yet
+		{[]*sqlserver.DealInfo{deal()}, nil, false},
+		{[]*sqlserver.DealInfo{deal("mssql-1"), deal()}, []string{"mssql-1"}, false},
+	}
+	for _, test := range tests {
+		instanceIds, complete := instanceIdsFromDeals(test.deals)
+		if !reflect.DeepEqual(instanceIds, test.instanceIds) || complete != test.complete {
+			t.Errorf("instanceIdsFromDeals(%v) = (%v, %v), expected (%v, %v)",
+				test.deals, instanceIds, complete, test.instanceIds, test.complete)
+		}
+	}
+}
@@ -431,7 +431,7 @@ getMoreData:
 		basicInfo.isDefault = *item.IsDefault
 		basicInfo.isMulticast = *item.EnableBroadcast
 
-		basicInfo.zone = *item.Zone
+		basicInfo.zone = strconv.FormatInt(*item.Zone, 10)
 		basicInfo.availableIpCount = int64(*item.AvailableIpAddressCount)
 
 		if hasSubnet[basicInfo.subnetId] {
@@ -347,3 +347,46 @@ func validateTime(layout string) schema.SchemaValidateFunc {
 		return
 	}
 }
+
+// validatePassword enforces the documented password complexity of the given service before
+// the API call, so a violation produces a clear client-side message instead of an opaque
+// server-side rejection. Supported services are "sqlserver" (8-32 characters containing at
+// least two of letters, digits and special characters) and "ckafka" (8-64 characters
+// containing both letters and digits).
+func validatePassword(password string, service string) error {
+	var letter, digit, special bool
+	for i := 0; i < len(password); i++ {
+		switch {
+		case password[i] >= '0' && password[i] <= '9':
+			digit = true
+		case (password[i] >= 'a' && password[i] <= 'z') || (password[i] >= 'A' && password[i] <= 'Z'):
+			letter = true
+		default:
+			special = true
+		}
+	}
+
+	switch service {
+	case "sqlserver":
+		if len(password) < 8 || len(password) > 32 {
+			return fmt.Errorf("invalid SQL Server account password, the length must be 8-32")
+		}
+		classes := 0
+		for _, matched := range []bool{letter, digit, special} {
+			if matched {
+				classes++
+			}
+		}
+		if classes < 2 {
+			return fmt.Errorf("invalid SQL Server account password, it must contain at least two of letters, digits and special characters")
+		}
+	case "ckafka":
+		if len(password) < 8 || len(password) > 64 {
+			return fmt.Errorf("invalid ckafka user password, the length must be 8-64")
+		}
+		if !letter || !digit {
+			return fmt.Errorf("invalid ckafka user password, it must contain both letters and digits")
+		}
+	}
+	return nil
+}